@@ -0,0 +1,64 @@
+package account
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+func NewClient(client *api.API) *Client {
+	return &Client{
+		API: client,
+	}
+}
+
+// GetProfile https://api.warren.io/#get-user-info
+func (c *Client) GetProfile(ctx context.Context) (*Profile, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/user-resource/user",
+	}
+	return api.Call[Profile](ctx, c.API, rc)
+}
+
+// ListTeamMembers https://api.warren.io/#list-team-members
+// Pending invitations are included with `MemberStatusInvited` status.
+func (c *Client) ListTeamMembers(ctx context.Context) (*[]TeamMember, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/user-resource/user/team",
+	}
+	var members []TeamMember
+	if err := c.API.FormRequest(ctx, rc).Into(&members); err != nil {
+		return nil, err
+	}
+	return &members, nil
+}
+
+// InviteTeamMember https://api.warren.io/#invite-team-member
+func (c *Client) InviteTeamMember(ctx context.Context, cfg InviteConfig) (*TeamMember, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	d, err := api.EncodeForm(cfg)
+	if err != nil {
+		return nil, err
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   "/v1/user-resource/user/team",
+		Data:   d,
+	}
+	return api.Call[TeamMember](ctx, c.API, rc)
+}
+
+// RemoveTeamMember https://api.warren.io/#remove-team-member
+// It also cancels pending invitation.
+func (c *Client) RemoveTeamMember(ctx context.Context, id int) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/user-resource/user/team/%d", id),
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
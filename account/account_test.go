@@ -0,0 +1,71 @@
+package account
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProfile(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/user-resource/user", r.RequestURI)
+		w.Write([]byte(`{"id":1,"email":"owner@example.com"}`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	p, err := c.GetProfile(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "owner@example.com", p.Email)
+}
+
+func TestListTeamMembers(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/team", r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	c.ListTeamMembers(context.Background())
+}
+
+func TestInviteTeamMember(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/team", r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, "dev@example.com", r.Form.Get("email"))
+		assert.Equal(t, RoleMember, r.Form.Get("role"))
+		w.Write([]byte(`{"id":2,"email":"dev@example.com","status":"invited"}`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	m, err := c.InviteTeamMember(context.Background(), InviteConfig{Email: "dev@example.com", Role: RoleMember})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, m.ID)
+	assert.Equal(t, MemberStatusInvited, m.Status)
+}
+
+func TestInviteConfig_Validate(t *testing.T) {
+	assert.NoError(t, InviteConfig{Email: "dev@example.com", Role: RoleAdmin}.Validate())
+	assert.EqualError(t, InviteConfig{Email: "dev", Role: RoleAdmin}.Validate(), `Email with value of "dev" is invalid`)
+	assert.EqualError(t, InviteConfig{Email: "dev@example.com", Role: "owner"}.Validate(), `Role with value of "owner" is invalid, must be one of admin, member`)
+}
+
+func TestRemoveTeamMember(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/team/2", r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	c.RemoveTeamMember(context.Background(), 2)
+}
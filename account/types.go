@@ -0,0 +1,62 @@
+package account
+
+import (
+	"fmt"
+	"net/mail"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+type Client struct {
+	API *api.API
+}
+
+// Team member roles
+const (
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+// Team member statuses
+const (
+	MemberStatusInvited = "invited"
+	MemberStatusActive  = "active"
+)
+
+// Profile is the user that owns the API key
+type Profile struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Phone     string `json:"phone"`
+	Company   string `json:"company"`
+	Country   string `json:"country"`
+	CreatedAt string `json:"created_at"`
+}
+
+// TeamMember is a collaborator with access to the account, or a pending invitation.
+type TeamMember struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"created_at"`
+}
+
+// InviteConfig holds parameters to invite a new team member
+type InviteConfig struct {
+	Email string `schema:"email"`
+	Role  string `schema:"role"`
+}
+
+// Validate checks fields that are required by the API, it's called by `Client.InviteTeamMember()` before sending the request.
+func (cfg InviteConfig) Validate() error {
+	if _, err := mail.ParseAddress(cfg.Email); err != nil {
+		return fmt.Errorf("Email with value of %q is invalid", cfg.Email)
+	}
+	if cfg.Role != RoleAdmin && cfg.Role != RoleMember {
+		return fmt.Errorf("Role with value of %q is invalid, must be one of %s, %s", cfg.Role, RoleAdmin, RoleMember)
+	}
+	return nil
+}
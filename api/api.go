@@ -2,12 +2,16 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
-	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 const (
@@ -15,90 +19,295 @@ const (
 	baseURLEnvKey string = "WARREN_API_BASE_URL"
 )
 
-// Default creates API where both BaseURL and APIKey comes from environment variables.
-var Default *API = New(os.Getenv(baseURLEnvKey), os.Getenv(apiKeyEnvKey))
+// Default creates API where both BaseURL and APIKey comes from environment variables,
+// API key is re-read from the environment on every request so it can be rotated,
+// APIKey is used when the variable is unset.
+var Default *API = New(os.Getenv(baseURLEnvKey), os.Getenv(apiKeyEnvKey), withEnvKey(apiKeyEnvKey))
 
-// ClientResponse is a data structured returned by `doRequest()`.
+// ClientResponse is a data structured returned by `FormRequest()` and `JSONRequest()`.
 // To make the client compatible even when the server changed their response format.
 // User of this library is responsible to handle the Body which is a slice of byte.
 type ClientResponse struct {
-	Error error
-	Body  []byte
+	Error      error
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+
+	// noBody is set for responses that have no body by definition (HEAD, 204 No Content)
+	noBody bool
+}
+
+// Into decodes JSON Body into v.
+// If the response already carries an error, that error is returned and v is left untouched.
+// Responses without body (to HEAD request, or 204 No Content) leave v untouched too.
+func (r *ClientResponse) Into(v interface{}) error {
+	if r.Error != nil {
+		return r.Error
+	}
+	if r.noBody && len(r.Body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(r.Body, v); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+	return nil
 }
 
 // API used to holds objects that are needed to make a HTTP call.
+// APIKey is ignored when credentials provider is set, see `WithCredentials()`.
+//
+// API is safe for concurrent use by multiple goroutines as long as its fields are not modified after the first call,
+// use `Clone()` to get a copy with different settings instead.
 type API struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+
+	credentials CredentialsProvider
+	envKey      string
+	envInitial  string
+	timeout     time.Duration
+	retry       retryPolicy
+	limiter     *rateLimiter
+	debug       *debugLogger
+	middlewares []Middleware
+	hooks       []Hook
+
+	userAgentSuffix string
+	cache           Cache
+	cacheTTL        time.Duration
+	breaker         *circuitBreaker
+
+	maxResponseBytes int64
+	dryRun           *dryRun
 }
 
-// FormRequest make a call with form-encoded payload
+// FormRequest make a call with form-encoded payload, nil ctx means `context.Background()`.
 func (a *API) FormRequest(ctx context.Context, cfg RequestConfig) *ClientResponse {
-	req, err := a.buildRequest(ctx, cfg)
-	if err != nil {
-		return &ClientResponse{Error: err}
-	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	return a.doRequest(req)
+	return a.request(ctx, cfg, "application/x-www-form-urlencoded")
 }
 
 // JsonRequest make a call with json-encoded payload
 func (a *API) JSONRequest(ctx context.Context, cfg RequestConfig) *ClientResponse {
-	req, err := a.buildRequest(ctx, cfg)
+	return a.request(ctx, cfg, "application/json")
+}
+
+// Request make a call with any HTTP method, payload is json-encoded when JSON is set or form-encoded otherwise.
+func (a *API) Request(ctx context.Context, cfg RequestConfig) *ClientResponse {
+	return a.request(ctx, cfg, cfg.contentType())
+}
+
+// request sends the request and reads the whole response body, unwrapping response envelope if any.
+// Cacheable response is served from cache when available.
+// The response as received is copied into RawResponse attached to ctx, see `CaptureRaw()`.
+func (a *API) request(ctx context.Context, cfg RequestConfig, contentType string) *ClientResponse {
+	key := a.cacheKey(cfg)
+	if key != "" {
+		if b, ok := a.cache.Get(key); ok {
+			resp := &ClientResponse{StatusCode: http.StatusOK, Body: b}
+			captureRaw(ctx, resp, b)
+			return resp
+		}
+	}
+
+	res, resp, cancel := a.open(ctx, cfg, contentType)
+	defer cancel()
+	if res == nil {
+		captureRaw(ctx, resp, resp.Body)
+		return resp
+	}
+	defer res.Body.Close()
+
+	resp.noBody = cfg.method() == http.MethodHead || res.StatusCode == http.StatusNoContent
+	b, err := readBody(res.Body, a.maxResponseBytes)
+	captureRaw(ctx, resp, b)
 	if err != nil {
-		return &ClientResponse{Error: err}
+		resp.Body = b
+		resp.Error = err
+		return resp
+	}
+	resp.Body, resp.Error = unwrapEnvelope(resp.StatusCode, b)
+	resp.Error = withRequestInfo(resp.Error, res)
+	if key != "" && resp.Error == nil {
+		a.cache.Set(key, resp.Body, a.cacheTTL)
+	}
+	return resp
+}
+
+// open sends the request within configured timeout and notifies hooks about the call.
+// Timeout, when set, covers all attempts including the time spent waiting between them,
+// it's released by calling the returned cancel which must be done once the body is consumed.
+// On success it returns response with unread body, otherwise only ClientResponse with the error is returned.
+func (a *API) open(ctx context.Context, cfg RequestConfig, contentType string) (*http.Response, *ClientResponse, context.CancelFunc) {
+	// nil context is accepted for quick scripts, every service method can be called with nil
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// generated once so every retry carries the same key
+	if cfg.IdempotencyKey == "" && cfg.mutating() {
+		cfg.IdempotencyKey = uuid.NewString()
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if timeout := cfg.timeout(a.timeout); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	info := CallInfo{Method: cfg.method(), Path: pathTemplate(cfg.Path)}
+	for _, h := range a.hooks {
+		ctx = h.BeforeCall(ctx, info)
+	}
+	start := time.Now()
+	res, resp, retries := a.guardedSend(ctx, cfg, contentType)
+
+	info.StatusCode = resp.StatusCode
+	info.Retries = retries
+	info.Duration = time.Since(start)
+	info.Err = resp.Error
+	for i := len(a.hooks) - 1; i >= 0; i-- {
+		a.hooks[i].AfterCall(ctx, info)
+	}
+	return res, resp, cancel
+}
+
+// send builds and sends the request, retrying transient failures when retry is enabled.
+// The request is rebuilt on every attempt so the body can be read again.
+// It returns the last response along with number of retries made, see `doRequest()`.
+func (a *API) send(ctx context.Context, cfg RequestConfig, contentType string) (*http.Response, *ClientResponse, int) {
+	for attempt := 0; ; attempt++ {
+		// waited before building the request so multipart body isn't left streaming when wait fails
+		if a.limiter != nil {
+			if err := a.limiter.wait(ctx); err != nil {
+				return nil, &ClientResponse{Error: err}, attempt
+			}
+		}
+		req, err := a.buildRequest(ctx, cfg, contentType)
+		if err != nil {
+			return nil, &ClientResponse{Error: err}, attempt
+		}
+		res, resp := a.doRequest(req)
+		// files are streamed once and can't be sent again
+		if attempt >= a.retry.max || len(cfg.Files) > 0 || !shouldRetry(ctx, resp) {
+			return res, resp, attempt
+		}
+		delay := a.retry.backoff(attempt)
+		var throttled *ThrottledError
+		if errors.As(resp.Error, &throttled) && throttled.RetryAfter > 0 {
+			// server knows better when to come back, give up early if ctx can't wait that long
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < throttled.RetryAfter {
+				return res, resp, attempt
+			}
+			delay = throttled.RetryAfter
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, &ClientResponse{Error: err}, attempt
+		}
 	}
-	req.Header.Set("Content-Type", "application/json")
-	return a.doRequest(req)
 }
 
 // buildRequest wraps `http.NewRequestWithContext` and set necessary header for authentication.
-func (a *API) buildRequest(ctx context.Context, cfg RequestConfig) (*http.Request, error) {
-	body, err := cfg.body()
+// Requests with Files are always sent as multipart form regardless of contentType.
+func (a *API) buildRequest(ctx context.Context, cfg RequestConfig, contentType string) (*http.Request, error) {
+	var body io.Reader
+	var err error
+	if len(cfg.Files) > 0 {
+		var mp io.ReadCloser
+		mp, contentType, err = cfg.multipartBody()
+		if err != nil {
+			return nil, err
+		}
+		// stop the writer when request can't be created
+		defer func() {
+			if err != nil {
+				mp.Close()
+			}
+		}()
+		body = mp
+	} else {
+		body, err = cfg.body()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, cfg.method(), cfg.url(a.BaseURL), body)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(cfg.Method), cfg.url(a.BaseURL), body)
+	key, err := a.apiKey(ctx)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("apikey", a.APIKey)
+	for k, v := range cfg.Headers {
+		req.Header[http.CanonicalHeaderKey(k)] = append([]string(nil), v...)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("apikey", key)
+	req.Header.Set("User-Agent", a.userAgent())
+	if cfg.IdempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", cfg.IdempotencyKey)
+	}
+	// set explicitly so deflate is accepted too, `decompressBody()` takes care of decoding
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
 	return req, nil
 }
 
-// doRequest doing the actual request
-func (a *API) doRequest(req *http.Request) *ClientResponse {
-	res, err := a.HTTPClient.Do(req)
+// doRequest doing the actual request.
+// Successful response is returned with unread body, failed one is fully read into ClientResponse.
+func (a *API) doRequest(req *http.Request) (*http.Response, *ClientResponse) {
+	res, err := a.roundTrip()(req)
 	if err != nil {
-		return &ClientResponse{Error: err}
+		return nil, &ClientResponse{Error: err}
 	}
-	defer res.Body.Close()
 
 	// we'll only accept 2xx and 3xx as success
 	if res.StatusCode >= 400 {
-		b, err := io.ReadAll(res.Body)
+		defer res.Body.Close()
+		b, err := readBody(res.Body, a.maxResponseBytes)
 		if err != nil {
-			return &ClientResponse{
-				Error: fmt.Errorf("api call failed with status code=%d", res.StatusCode),
+			return nil, &ClientResponse{
+				StatusCode: res.StatusCode,
+				Header:     res.Header,
+				Error:      newResponseError(res, nil),
 			}
 		}
-		return &ClientResponse{
-			Body:  b,
-			Error: fmt.Errorf("api call failed with status code=%d: %s", res.StatusCode, b),
+		return nil, &ClientResponse{
+			StatusCode: res.StatusCode,
+			Header:     res.Header,
+			Body:       b,
+			Error:      newResponseError(res, b),
 		}
 	}
-	b, err := io.ReadAll(res.Body)
-	return &ClientResponse{Body: b, Error: err}
+	return res, &ClientResponse{StatusCode: res.StatusCode, Header: res.Header}
 }
 
 // New create an instance of API
-func New(baseURL, apiKey string) *API {
-	return &API{
+func New(baseURL, apiKey string, opts ...Option) *API {
+	a := &API{
 		BaseURL:    baseURL,
 		APIKey:     apiKey,
 		HTTPClient: http.DefaultClient,
 	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Clone returns copy of API with opts applied, the original API is left unchanged so it's safe
+// to be called while other goroutines are using it. HTTP client and rate limiter are shared with the original.
+func (a *API) Clone(opts ...Option) *API {
+	c := *a
+	// copied so appending to them doesn't affect the original
+	c.middlewares = append([]Middleware(nil), a.middlewares...)
+	c.hooks = append([]Hook(nil), a.hooks...)
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return &c
 }
 
 // MockClientServer returns API client and test server to simplify API call testing
@@ -3,9 +3,13 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -16,6 +20,43 @@ func TestNew(t *testing.T) {
 	assert.Equal(t, "secret", c.APIKey)
 }
 
+func TestAPI_Clone(t *testing.T) {
+	mw := func(next RoundTripFunc) RoundTripFunc { return next }
+	a := New("https://api.warren.io", "secret", WithMiddleware(mw), WithRetry(3, time.Second))
+
+	c := a.Clone(WithAPIKey("other"), WithBaseURL("https://api.example.com"), WithMiddleware(mw))
+	assert.Equal(t, "secret", a.APIKey)
+	assert.Equal(t, "https://api.warren.io", a.BaseURL)
+	assert.Len(t, a.middlewares, 1)
+
+	assert.Equal(t, "other", c.APIKey)
+	assert.Equal(t, "https://api.example.com", c.BaseURL)
+	assert.Len(t, c.middlewares, 2)
+	assert.Equal(t, a.retry, c.retry)
+	assert.Same(t, a.HTTPClient, c.HTTPClient)
+}
+
+func TestAPI_Concurrent(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Header.Get("apikey")))
+	})
+	defer s.Close()
+	WithRateLimit(1000, 10)(c)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			key := fmt.Sprintf("key-%d", i)
+			resp := c.Clone(WithAPIKey(key)).FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+			assert.NoError(t, resp.Error)
+			assert.Equal(t, key, string(resp.Body))
+		}(i)
+	}
+	wg.Wait()
+}
+
 func TestFormRequest_NoContext(t *testing.T) {
 	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
 		w.Write([]byte("OK"))
@@ -27,7 +68,8 @@ func TestFormRequest_NoContext(t *testing.T) {
 		Path:   "/test",
 	}
 	resp := c.FormRequest(nil, cfg)
-	assert.Error(t, resp.Error)
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, []byte("OK"), resp.Body)
 }
 
 func TestFormRequest_MethodInvalid(t *testing.T) {
@@ -49,6 +91,7 @@ func TestFormRequest_GET(t *testing.T) {
 		assert.Equal(t, "GET", r.Method)
 		assert.Equal(t, "/test", r.RequestURI)
 		assert.Equal(t, "secret", r.Header.Get("apikey"))
+		assert.Empty(t, r.Header.Get("Idempotency-Key"))
 		w.Write([]byte("OK"))
 	})
 	defer s.Close()
@@ -61,6 +104,37 @@ func TestFormRequest_GET(t *testing.T) {
 	assert.Equal(t, []byte("OK"), resp.Body)
 }
 
+func TestFormRequest_Headers(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, []string{"abc", "def"}, r.Header.Values("X-Trace-Id"))
+		assert.Equal(t, "secret", r.Header.Get("apikey"))
+	})
+	defer s.Close()
+
+	cfg := RequestConfig{
+		Method:  "GET",
+		Path:    "/test",
+		Headers: http.Header{"x-trace-id": {"abc", "def"}, "Apikey": {"override"}},
+	}
+	resp := c.FormRequest(context.Background(), cfg)
+	assert.NoError(t, resp.Error)
+}
+
+func TestFormRequest_IdempotencyKey(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "my-key", r.Header.Get("Idempotency-Key"))
+	})
+	defer s.Close()
+
+	cfg := RequestConfig{
+		Method:         "POST",
+		Path:           "/test",
+		IdempotencyKey: "my-key",
+	}
+	resp := c.FormRequest(context.Background(), cfg)
+	assert.NoError(t, resp.Error)
+}
+
 func TestFormRequest_GET_QueryParams(t *testing.T) {
 	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "GET", r.Method)
@@ -143,3 +217,49 @@ func TestJSONRequest(t *testing.T) {
 	resp := c.JSONRequest(context.Background(), cfg)
 	assert.Equal(t, []byte("OK"), resp.Body)
 }
+
+func TestClientResponse_Into(t *testing.T) {
+	var data map[string]interface{}
+
+	// response with error
+	resp := &ClientResponse{Error: errors.New("failed")}
+	assert.EqualError(t, resp.Into(&data), "failed")
+	assert.Nil(t, data)
+
+	// invalid json
+	resp = &ClientResponse{Body: []byte("OK")}
+	assert.ErrorContains(t, resp.Into(&data), "failed to decode response body")
+
+	// success
+	resp = &ClientResponse{Body: []byte(`{"name":"test"}`)}
+	assert.NoError(t, resp.Into(&data))
+	assert.Equal(t, "test", data["name"])
+}
+
+func TestRequest_Methods(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodHead:
+			w.Header().Set("X-Total", "3")
+		case http.MethodOptions:
+			w.Header().Set("Allow", "GET, PUT")
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPut:
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			w.Write([]byte(`{"id":1}`))
+		}
+	})
+	defer s.Close()
+
+	var v struct{ ID int }
+	resp := a.Request(context.Background(), RequestConfig{Method: "HEAD", Path: "/"})
+	assert.NoError(t, resp.Into(&v))
+	assert.Equal(t, "3", resp.Header.Get("X-Total"))
+
+	resp = a.Request(context.Background(), RequestConfig{Method: "options", Path: "/"})
+	assert.NoError(t, resp.Into(&v))
+	assert.Equal(t, "GET, PUT", resp.Header.Get("Allow"))
+
+	assert.NoError(t, a.Request(context.Background(), RequestConfig{Method: "PUT", Path: "/", JSON: map[string]int{"id": 1}}).Into(&v))
+	assert.Equal(t, 1, v.ID)
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling the API while circuit breaker is open, see `WithCircuitBreaker()`.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// WithCircuitBreaker stops sending requests after threshold consecutive failed calls (network errors and 5xx),
+// failing fast with `ErrCircuitOpen` instead. After cooldown a single call is let through,
+// the circuit is closed again when it succeeds, otherwise it stays open for another cooldown.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) Option {
+	return func(a *API) {
+		if threshold <= 0 {
+			a.breaker = nil
+			return
+		}
+		a.breaker = &circuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+	}
+}
+
+// guardedSend sends the request through circuit breaker when it's enabled, see `send()`.
+func (a *API) guardedSend(ctx context.Context, cfg RequestConfig, contentType string) (*http.Response, *ClientResponse, int) {
+	if a.breaker == nil {
+		return a.send(ctx, cfg, contentType)
+	}
+	if err := a.breaker.allow(); err != nil {
+		return nil, &ClientResponse{Error: err}, 0
+	}
+	res, resp, retries := a.send(ctx, cfg, contentType)
+	a.breaker.record(ctx, resp)
+	return res, resp, retries
+}
+
+// circuitBreaker counts consecutive failures, it's shared by all calls of the API.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openedAt  time.Time
+	probing   bool
+	now       func() time.Time
+}
+
+// allow tells whether a call can be made, letting a single probe through once cooldown is over.
+func (b *circuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return nil
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return ErrCircuitOpen
+	}
+	b.probing = true
+	return nil
+}
+
+// record updates the breaker with result of a call, calls canceled by ctx are not counted.
+func (b *circuitBreaker) record(ctx context.Context, resp *ClientResponse) {
+	failed := resp.Error != nil && (resp.StatusCode == 0 || resp.StatusCode >= 500)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if failed && ctx.Err() != nil {
+		return
+	}
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCircuitBreaker(t *testing.T) {
+	a := New("https://api.warren.io", "secret", WithCircuitBreaker(3, time.Second))
+	assert.Equal(t, 3, a.breaker.threshold)
+	assert.Equal(t, time.Second, a.breaker.cooldown)
+
+	WithCircuitBreaker(0, time.Second)(a)
+	assert.Nil(t, a.breaker)
+}
+
+func TestFormRequest_CircuitBreaker(t *testing.T) {
+	status := http.StatusInternalServerError
+	calls := 0
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(status)
+	})
+	defer s.Close()
+	WithCircuitBreaker(2, time.Minute)(c)
+	now := time.Now()
+	c.breaker.now = func() time.Time { return now }
+
+	cfg := RequestConfig{Method: "GET", Path: "/test"}
+	ctx := context.Background()
+
+	// client errors don't count
+	status = http.StatusNotFound
+	c.FormRequest(ctx, cfg)
+	c.FormRequest(ctx, cfg)
+	assert.NoError(t, c.breaker.allow())
+
+	status = http.StatusInternalServerError
+	assert.ErrorIs(t, c.FormRequest(ctx, cfg).Error, ErrServer)
+	assert.ErrorIs(t, c.FormRequest(ctx, cfg).Error, ErrServer)
+	assert.Equal(t, 4, calls)
+
+	// open, fails fast
+	assert.ErrorIs(t, c.FormRequest(ctx, cfg).Error, ErrCircuitOpen)
+	assert.Equal(t, 4, calls)
+
+	// failed probe keeps it open
+	now = now.Add(time.Minute)
+	assert.ErrorIs(t, c.FormRequest(ctx, cfg).Error, ErrServer)
+	assert.ErrorIs(t, c.FormRequest(ctx, cfg).Error, ErrCircuitOpen)
+	assert.Equal(t, 5, calls)
+
+	// successful probe closes it
+	now = now.Add(time.Minute)
+	status = http.StatusOK
+	assert.NoError(t, c.FormRequest(ctx, cfg).Error)
+	assert.NoError(t, c.FormRequest(ctx, cfg).Error)
+	assert.Equal(t, 7, calls)
+}
+
+func TestCircuitBreaker_SingleProbe(t *testing.T) {
+	now := time.Now()
+	b := &circuitBreaker{threshold: 1, cooldown: time.Second, now: func() time.Time { return now }}
+	b.record(context.Background(), &ClientResponse{Error: ErrServer})
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	now = now.Add(time.Second)
+	assert.NoError(t, b.allow())
+	assert.ErrorIs(t, b.allow(), ErrCircuitOpen)
+
+	// canceled probe releases the slot without closing the circuit
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	b.record(ctx, &ClientResponse{Error: context.Canceled})
+	assert.NoError(t, b.allow())
+}
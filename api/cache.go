@@ -0,0 +1,69 @@
+package api
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Cache stores response bodies of cacheable calls, see `WithCache()`.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// WithCache caches successful responses of GET calls marked as `RequestConfig.Cacheable` for ttl,
+// e.g. image catalog and locations which rarely change. Cached responses skip hooks and middlewares.
+// Entries are keyed by request URL, so don't share a cache between clients of different accounts.
+func WithCache(c Cache, ttl time.Duration) Option {
+	return func(a *API) {
+		a.cache = c
+		a.cacheTTL = ttl
+	}
+}
+
+// cacheKey returns cache key of the call, empty when it can't be cached.
+func (a *API) cacheKey(cfg RequestConfig) string {
+	if a.cache == nil || a.cacheTTL <= 0 || !cfg.Cacheable || cfg.method() != http.MethodGet {
+		return ""
+	}
+	return cfg.url(a.BaseURL)
+}
+
+// MemoryCache is in-memory `Cache`, expired entries are removed when they're read.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	now     func() time.Time
+}
+
+type cacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]cacheEntry{}, now: time.Now}
+}
+
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (c *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = cacheEntry{value: value, expires: c.now().Add(ttl)}
+}
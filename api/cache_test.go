@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewMemoryCache()
+	c.now = func() time.Time { return now }
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+
+	c.Set("a", []byte("1"), time.Minute)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, []byte("1"), v)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Empty(t, c.entries)
+}
+
+func TestFormRequest_Cache(t *testing.T) {
+	calls := 0
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"success":true,"data":[1]}`))
+	})
+	defer s.Close()
+	WithCache(NewMemoryCache(), time.Minute)(c)
+
+	cfg := RequestConfig{Method: "GET", Path: "/images", Cacheable: true}
+	for i := 0; i < 3; i++ {
+		resp := c.FormRequest(context.Background(), cfg)
+		assert.NoError(t, resp.Error)
+		assert.Equal(t, []byte("[1]"), resp.Body)
+	}
+	assert.Equal(t, 1, calls)
+
+	// different query is a different entry
+	cfg.Page = 2
+	c.FormRequest(context.Background(), cfg)
+	assert.Equal(t, 2, calls)
+
+	// not cacheable
+	c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/images"})
+	assert.Equal(t, 3, calls)
+
+	// errors are not cached
+	cfg = RequestConfig{Method: "GET", Path: "/fail", Cacheable: true}
+	c.FormRequest(context.Background(), cfg)
+	c.FormRequest(context.Background(), cfg)
+	assert.Equal(t, 5, calls)
+
+	// mutating calls are never cached
+	cfg = RequestConfig{Method: "POST", Path: "/images", Cacheable: true}
+	c.FormRequest(context.Background(), cfg)
+	c.FormRequest(context.Background(), cfg)
+	assert.Equal(t, 7, calls)
+}
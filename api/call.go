@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/gorilla/schema"
+)
+
+// Call sends form-encoded request and decodes response into T, see `FormRequest()`.
+// It shortens typical endpoint into a single call:
+//
+//	func (c *Client) GetDisk(ctx context.Context, id uuid.UUID) (*Disk, error) {
+//		return api.Call[Disk](ctx, c.API, api.RequestConfig{Method: "GET", Path: "/v1/storage/disks/" + id.String()})
+//	}
+func Call[T any](ctx context.Context, a *API, cfg RequestConfig) (*T, error) {
+	var v T
+	if err := a.FormRequest(ctx, cfg).Into(&v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// CallJSON is like `Call()` but sends json-encoded request, see `JSONRequest()`.
+func CallJSON[T any](ctx context.Context, a *API, cfg RequestConfig) (*T, error) {
+	var v T
+	if err := a.JSONRequest(ctx, cfg).Into(&v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// EncodeForm encodes struct with `schema` tags into form values to be used as RequestConfig.Data.
+func EncodeForm(v interface{}) (url.Values, error) {
+	d := url.Values{}
+	if err := schema.NewEncoder().Encode(v, d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
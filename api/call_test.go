@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type callResult struct {
+	Name string `json:"name"`
+}
+
+func TestCall(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		w.Write([]byte(`{"success":true,"data":{"name":"test"}}`))
+	})
+	defer s.Close()
+
+	res, err := Call[callResult](context.Background(), c, RequestConfig{Method: "GET", Path: "/test"})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", res.Name)
+
+	list, err := Call[[]callResult](context.Background(), c, RequestConfig{Method: "GET", Path: "/test"})
+	assert.Error(t, err)
+	assert.Nil(t, list)
+}
+
+func TestCallJSON(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer s.Close()
+
+	res, err := CallJSON[callResult](context.Background(), c, RequestConfig{Method: "POST", Path: "/test", JSON: map[string]string{}})
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, res)
+}
+
+func TestEncodeForm(t *testing.T) {
+	d, err := EncodeForm(struct {
+		Name string `schema:"name"`
+		Size int    `schema:"size,omitempty"`
+	}{Name: "test"})
+	assert.NoError(t, err)
+	assert.Equal(t, "name=test", d.Encode())
+}
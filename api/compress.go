@@ -0,0 +1,90 @@
+package api
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrResponseTooLarge is returned when response body exceeds the limit set by `WithMaxResponseBytes()`.
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// acceptEncoding is sent with every request unless set in RequestConfig.Headers
+const acceptEncoding = "gzip, deflate"
+
+// WithMaxResponseBytes aborts reading response body larger than max bytes (after decompression)
+// with `ErrResponseTooLarge`, to protect memory when an endpoint unexpectedly returns huge payload.
+// Zero means no limit. Streamed bodies of `Do()` and `Download()` are not limited.
+func WithMaxResponseBytes(max int64) Option {
+	return func(a *API) {
+		a.maxResponseBytes = max
+	}
+}
+
+// decompress decodes response body of next, see `decompressBody()`.
+func decompress(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		res, err := next(req)
+		if err != nil {
+			return res, err
+		}
+		if err := decompressBody(res); err != nil {
+			res.Body.Close()
+			return nil, err
+		}
+		return res, nil
+	}
+}
+
+// decompressBody replaces gzip or deflate encoded body of res with decoded one.
+func decompressBody(res *http.Response) error {
+	var body io.ReadCloser
+	var err error
+	switch strings.ToLower(strings.TrimSpace(res.Header.Get("Content-Encoding"))) {
+	case "gzip":
+		body, err = gzip.NewReader(res.Body)
+	case "deflate":
+		body, err = zlib.NewReader(res.Body)
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decompress response body: %w", err)
+	}
+	res.Body = &decompressedBody{ReadCloser: body, raw: res.Body}
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+	return nil
+}
+
+// decompressedBody closes both decoder and the underlying body.
+type decompressedBody struct {
+	io.ReadCloser
+	raw io.Closer
+}
+
+func (b *decompressedBody) Close() error {
+	b.ReadCloser.Close()
+	return b.raw.Close()
+}
+
+// readBody reads the whole body, failing when it's larger than max bytes, zero max means no limit.
+func readBody(r io.Reader, max int64) ([]byte, error) {
+	if max <= 0 {
+		return io.ReadAll(r)
+	}
+	b, err := io.ReadAll(io.LimitReader(r, max+1))
+	if err != nil {
+		return b, err
+	}
+	if int64(len(b)) > max {
+		return nil, fmt.Errorf("%w, limit is %d bytes", ErrResponseTooLarge, max)
+	}
+	return b, nil
+}
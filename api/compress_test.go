@@ -0,0 +1,73 @@
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressedResponse(t *testing.T) {
+	for _, enc := range []string{"gzip", "deflate"} {
+		t.Run(enc, func(t *testing.T) {
+			a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "gzip, deflate", r.Header.Get("Accept-Encoding"))
+
+				var b bytes.Buffer
+				var zw interface {
+					Write([]byte) (int, error)
+					Close() error
+				}
+				if enc == "gzip" {
+					zw = gzip.NewWriter(&b)
+				} else {
+					zw = zlib.NewWriter(&b)
+				}
+				zw.Write([]byte(`{"id":1}`))
+				zw.Close()
+
+				w.Header().Set("Content-Encoding", enc)
+				w.Write(b.Bytes())
+			})
+			defer s.Close()
+
+			v, err := Call[struct{ ID int }](context.Background(), a, RequestConfig{Method: "GET", Path: "/"})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, v.ID)
+		})
+	}
+}
+
+func TestCompressedResponse_Invalid(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write([]byte(`{"id":1}`))
+	})
+	defer s.Close()
+
+	err := a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"}).Error
+	assert.ErrorContains(t, err, "failed to decompress response body")
+}
+
+func TestWithMaxResponseBytes(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(strings.Repeat("a", 11)))
+	})
+	defer s.Close()
+
+	a = a.Clone(WithMaxResponseBytes(10))
+	err := a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"}).Error
+	assert.True(t, errors.Is(err, ErrResponseTooLarge))
+	assert.EqualError(t, err, "response body too large, limit is 10 bytes")
+
+	a = a.Clone(WithMaxResponseBytes(11))
+	resp := a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"})
+	assert.NoError(t, resp.Error)
+	assert.Len(t, resp.Body, 11)
+}
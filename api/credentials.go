@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// CredentialsProvider supplies API key on every request, so keys can be fetched from a secret store
+// and rotated without recreating the client.
+type CredentialsProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// CredentialsFunc is an adapter to use ordinary function as CredentialsProvider.
+type CredentialsFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx).
+func (f CredentialsFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticCredentials always returns the same API key.
+func StaticCredentials(apiKey string) CredentialsProvider {
+	return CredentialsFunc(func(context.Context) (string, error) {
+		return apiKey, nil
+	})
+}
+
+// EnvCredentials reads API key from environment variable name on every request.
+func EnvCredentials(name string) CredentialsProvider {
+	return CredentialsFunc(func(context.Context) (string, error) {
+		key := os.Getenv(name)
+		if key == "" {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return key, nil
+	})
+}
+
+// WithCredentials makes API get its key from p, taking precedence over APIKey.
+func WithCredentials(p CredentialsProvider) Option {
+	return func(a *API) {
+		a.credentials = p
+	}
+}
+
+// withEnvKey makes API read its key from environment variable name on every request, falling back to APIKey
+// when it's unset. Once APIKey is changed from the value it had when the option was applied, the explicit key wins.
+// Credentials provider takes precedence over both.
+func withEnvKey(name string) Option {
+	return func(a *API) {
+		a.envKey = name
+		a.envInitial = a.APIKey
+	}
+}
+
+// apiKey returns key to authenticate the request with.
+func (a *API) apiKey(ctx context.Context) (string, error) {
+	if a.credentials == nil {
+		if a.envKey != "" && a.APIKey == a.envInitial {
+			if key := os.Getenv(a.envKey); key != "" {
+				return key, nil
+			}
+		}
+		return a.APIKey, nil
+	}
+	key, err := a.credentials.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get api key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCredentials(t *testing.T) {
+	var keys []string
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("apikey"))
+	})
+	defer s.Close()
+
+	current := "key-1"
+	WithCredentials(CredentialsFunc(func(context.Context) (string, error) {
+		return current, nil
+	}))(a)
+
+	a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"})
+	current = "key-2"
+	a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"})
+	assert.Equal(t, []string{"key-1", "key-2"}, keys)
+}
+
+func TestWithCredentials_Error(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	})
+	defer s.Close()
+
+	vaultErr := errors.New("vault sealed")
+	WithCredentials(CredentialsFunc(func(context.Context) (string, error) {
+		return "", vaultErr
+	}))(a)
+
+	resp := a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"})
+	assert.ErrorIs(t, resp.Error, vaultErr)
+	assert.EqualError(t, resp.Error, "failed to get api key: vault sealed")
+}
+
+func TestStaticCredentials(t *testing.T) {
+	key, err := StaticCredentials("secret").Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", key)
+}
+
+func TestEnvCredentials(t *testing.T) {
+	p := EnvCredentials("WARREN_TEST_API_KEY")
+
+	t.Setenv("WARREN_TEST_API_KEY", "")
+	_, err := p.Token(context.Background())
+	assert.EqualError(t, err, "environment variable WARREN_TEST_API_KEY is not set")
+
+	t.Setenv("WARREN_TEST_API_KEY", "rotated")
+	key, err := p.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated", key)
+}
+
+func TestDefault_APIKey(t *testing.T) {
+	t.Setenv("WARREN_API_KEY", "")
+	a := New("", os.Getenv("WARREN_API_KEY"), withEnvKey("WARREN_API_KEY"))
+
+	// key is re-read from the environment while it's not set in code
+	key, err := a.apiKey(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "", key)
+	t.Setenv("WARREN_API_KEY", "rotated")
+	key, err = a.apiKey(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "rotated", key)
+
+	// key set in code wins, whether the variable is set or not
+	a.APIKey = "static"
+	key, err = a.apiKey(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "static", key)
+	t.Setenv("WARREN_API_KEY", "")
+	key, err = a.apiKey(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "static", key)
+
+	// even when it's the same as the initial value
+	t.Setenv("WARREN_API_KEY", "rotated")
+	b := a.Clone(WithAPIKey(""))
+	key, err = b.apiKey(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "", key)
+
+	// provider still takes precedence
+	a = a.Clone(WithCredentials(StaticCredentials("vault")))
+	key, err = a.apiKey(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "vault", key)
+}
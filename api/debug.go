@@ -0,0 +1,142 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+)
+
+// redactedHeaders never get written in debug output
+var redactedHeaders = []string{"apikey", "Authorization"}
+
+// maxDebugBody is how much of request and response bodies is dumped, the rest is streamed through unread.
+const maxDebugBody = 64 << 10
+
+// debugLogger dumps requests and responses to w.
+type debugLogger struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// WithDebug dumps every outgoing request and incoming response to w, with credentials and secret body fields
+// (e.g. password, see `RedactBody()`) redacted.
+// Dumps reflect requests as they're sent, after all middlewares have been applied.
+// Bodies are dumped up to 64KB, larger ones are marked as truncated.
+func WithDebug(w io.Writer) Option {
+	return func(a *API) {
+		if w == nil {
+			a.debug = nil
+			return
+		}
+		a.debug = &debugLogger{w: w}
+	}
+}
+
+// wrap returns RoundTripFunc that dumps request and response around next.
+func (l *debugLogger) wrap(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		l.dumpRequest(req)
+		res, err := next(req)
+		if err != nil {
+			l.write(fmt.Sprintf("<<< %s %s failed: %v\n\n", req.Method, req.URL, err))
+			return res, err
+		}
+		l.dumpResponse(res)
+		return res, nil
+	}
+}
+
+func (l *debugLogger) dumpRequest(req *http.Request) {
+	body, err := peekBody(&req.Body, maxDebugBody)
+	if err != nil {
+		l.write(fmt.Sprintf(">>> failed to dump request: %v\n\n", err))
+		return
+	}
+
+	saved := map[string][]string{}
+	for _, h := range redactedHeaders {
+		if v, ok := req.Header[http.CanonicalHeaderKey(h)]; ok {
+			saved[h] = v
+			req.Header.Set(h, "REDACTED")
+		}
+	}
+	// body is dumped separately so it can be redacted
+	b, err := httputil.DumpRequestOut(req, false)
+	for h, v := range saved {
+		req.Header[http.CanonicalHeaderKey(h)] = v
+	}
+
+	if err != nil {
+		l.write(fmt.Sprintf(">>> failed to dump request: %v\n\n", err))
+		return
+	}
+	l.write(fmt.Sprintf(">>> request\n%s%s\n\n", b, dumpBody(body, maxDebugBody)))
+}
+
+func (l *debugLogger) dumpResponse(res *http.Response) {
+	body, err := peekBody(&res.Body, maxDebugBody)
+	if err != nil {
+		l.write(fmt.Sprintf("<<< failed to dump response: %v\n\n", err))
+		return
+	}
+	b, err := httputil.DumpResponse(res, false)
+	if err != nil {
+		l.write(fmt.Sprintf("<<< failed to dump response: %v\n\n", err))
+		return
+	}
+	l.write(fmt.Sprintf("<<< response\n%s%s\n\n", b, dumpBody(body, maxDebugBody)))
+}
+
+// peekBody reads up to limit+1 bytes of body and puts them back in front of the unread rest,
+// so the body can still be read in full and large ones aren't buffered.
+func peekBody(body *io.ReadCloser, limit int64) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	rc := *body
+	b, err := io.ReadAll(io.LimitReader(rc, limit+1))
+	*body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(b), rc), rc}
+	return b, err
+}
+
+// dumpBody returns redacted body, truncated to limit bytes with a marker when it's longer.
+// Truncated JSON can't be redacted, so it's left out when it may contain secret fields.
+func dumpBody(body []byte, limit int) []byte {
+	if len(body) <= limit {
+		return RedactBody(body)
+	}
+	head := body[:limit]
+	trimmed := bytes.TrimSpace(head)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && mentionsSecretField(trimmed) {
+		return []byte(fmt.Sprintf("... (more than %d bytes, omitted as it may contain secret fields)", limit))
+	}
+	// drop incomplete last pair of form body, a cut escape would keep it from being parsed and redacted
+	if i := bytes.LastIndexByte(head, '&'); i >= 0 {
+		head = head[:i]
+	}
+	return append(RedactBody(head), fmt.Sprintf("\n... (truncated, more than %d bytes)", limit)...)
+}
+
+// mentionsSecretField tells whether JSON b has any of `SecretFields` as key.
+func mentionsSecretField(b []byte) bool {
+	lower := bytes.ToLower(b)
+	for _, f := range SecretFields {
+		if bytes.Contains(lower, []byte(`"`+strings.ToLower(f)+`"`)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *debugLogger) write(s string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	io.WriteString(l.w, s)
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDebug(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		// server still receives the real key
+		assert.Equal(t, "secret", r.Header.Get("apikey"))
+
+		_ = r.ParseForm()
+		assert.Equal(t, "test", r.Form.Get("name"))
+		w.Write([]byte("pong"))
+	})
+	defer s.Close()
+
+	var buf bytes.Buffer
+	WithDebug(&buf)(c)
+
+	cfg := RequestConfig{
+		Method: "POST",
+		Path:   "/test",
+		Data:   url.Values{"name": []string{"test"}},
+	}
+	resp := c.FormRequest(context.Background(), cfg)
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, []byte("pong"), resp.Body)
+
+	out := buf.String()
+	assert.Contains(t, out, "POST /test HTTP/1.1")
+	assert.Contains(t, out, "Apikey: REDACTED")
+	assert.NotContains(t, out, "secret")
+	assert.Contains(t, out, "name=test")
+	assert.Contains(t, out, "200 OK")
+	assert.Contains(t, out, "pong")
+}
+
+func TestWithDebug_Disabled(t *testing.T) {
+	a := New("https://api.warren.io", "secret", WithDebug(nil))
+	assert.Nil(t, a.debug)
+}
+
+func TestWithDebug_Redacted(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		assert.Equal(t, "s3cret", r.Form.Get("password"))
+		w.Write([]byte(`{"uuid":"1","token":"t0ken"}`))
+	})
+	defer s.Close()
+
+	var buf bytes.Buffer
+	WithDebug(&buf)(c)
+
+	cfg := RequestConfig{
+		Method: "POST",
+		Path:   "/test",
+		Data:   url.Values{"name": {"test"}, "password": {"s3cret"}},
+	}
+	var v struct{ Token string }
+	assert.NoError(t, c.FormRequest(context.Background(), cfg).Into(&v))
+	// caller still gets the real values
+	assert.Equal(t, "t0ken", v.Token)
+
+	out := buf.String()
+	assert.Contains(t, out, "name=test&password=REDACTED")
+	assert.Contains(t, out, `{"token":"REDACTED","uuid":"1"}`)
+	assert.NotContains(t, out, "s3cret")
+	assert.NotContains(t, out, "t0ken")
+}
+
+func TestWithDebug_Truncated(t *testing.T) {
+	large := strings.Repeat("a", maxDebugBody+100)
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		assert.Len(t, b, len("password=s3cret&xdata=")+len(large))
+		w.Write([]byte(`[{"password":"s3cret","name":"` + large + `"}]`))
+	})
+	defer s.Close()
+
+	var buf bytes.Buffer
+	WithDebug(&buf)(c)
+
+	cfg := RequestConfig{
+		Method: "POST",
+		Path:   "/test",
+		Data:   url.Values{"password": {"s3cret"}, "xdata": {large}},
+	}
+	resp := c.FormRequest(context.Background(), cfg)
+	assert.NoError(t, resp.Error)
+	// bodies are passed through in full
+	assert.Len(t, resp.Body, len(`[{"password":"s3cret","name":""}]`)+len(large))
+
+	out := buf.String()
+	assert.Contains(t, out, "password=REDACTED\n... (truncated, more than 65536 bytes)")
+	assert.Contains(t, out, "... (more than 65536 bytes, omitted as it may contain secret fields)")
+	assert.NotContains(t, out, "s3cret")
+	assert.Less(t, buf.Len(), 2*maxDebugBody)
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// Response is a raw API response returned by `Do()`.
+// Body is streamed from the server and must be closed by the caller.
+// ContentLength is -1 when unknown.
+type Response struct {
+	StatusCode    int
+	Header        http.Header
+	ContentLength int64
+	Body          io.ReadCloser
+}
+
+// Do makes a call like `FormRequest()`, or `JSONRequest()` when cfg.JSON is set, but leaves the response body unread.
+// Use it for large or non-JSON payloads. Errors (including status code >= 400) are returned the same way as
+// `ClientResponse.Error`, in which case the body has already been consumed and Response is nil.
+// Timeout (see `WithTimeout()`) keeps running while the body is read, and hooks are notified once headers are received.
+// Unlike `FormRequest()`, response envelope is left as is.
+func (a *API) Do(ctx context.Context, cfg RequestConfig) (*Response, error) {
+	res, resp, cancel := a.open(ctx, cfg, cfg.contentType())
+	if res == nil {
+		cancel()
+		return nil, resp.Error
+	}
+	return &Response{
+		StatusCode:    res.StatusCode,
+		Header:        res.Header,
+		ContentLength: res.ContentLength,
+		Body:          &cancelBody{ReadCloser: res.Body, cancel: cancel},
+	}, nil
+}
+
+// cancelBody releases request context once the body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDo(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/kubeconfig", r.RequestURI)
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write([]byte("apiVersion: v1"))
+	})
+	defer s.Close()
+
+	res, err := a.Do(context.Background(), RequestConfig{Method: "get", Path: "/v1/kubeconfig"})
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, 200, res.StatusCode)
+	assert.Equal(t, "application/yaml", res.Header.Get("Content-Type"))
+	b, err := io.ReadAll(res.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1", string(b))
+}
+
+func TestDo_JSON(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+	})
+	defer s.Close()
+
+	res, err := a.Do(context.Background(), RequestConfig{Method: "POST", Path: "/", JSON: map[string]int{"a": 1}})
+	assert.NoError(t, err)
+	res.Body.Close()
+}
+
+func TestDo_Error(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer s.Close()
+
+	res, err := a.Do(context.Background(), RequestConfig{Method: "GET", Path: "/"})
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestDo_TimeoutCoversBody(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("start"))
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	defer s.Close()
+	WithTimeout(50 * time.Millisecond)(a)
+
+	res, err := a.Do(context.Background(), RequestConfig{Method: "GET", Path: "/"})
+	assert.NoError(t, err)
+	defer res.Body.Close()
+
+	_, err = io.ReadAll(res.Body)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+	"io"
+)
+
+// ProgressFunc is called as download progresses with number of bytes written so far,
+// total is taken from Content-Length and is -1 when unknown.
+type ProgressFunc func(written, total int64)
+
+// Download streams response body of the call into w without loading it into memory, see `Do()`.
+// Progress, when not nil, is called after every chunk written. It returns number of bytes written.
+func (a *API) Download(ctx context.Context, cfg RequestConfig, w io.Writer, progress ProgressFunc) (int64, error) {
+	res, err := a.Do(ctx, cfg)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if progress != nil {
+		w = &progressWriter{w: w, total: res.ContentLength, fn: progress}
+	}
+	return io.Copy(w, res.Body)
+}
+
+// progressWriter reports number of bytes written through it.
+type progressWriter struct {
+	w       io.Writer
+	written int64
+	total   int64
+	fn      ProgressFunc
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	p.fn(p.written, p.total)
+	return n, err
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownload(t *testing.T) {
+	payload := strings.Repeat("x", 100000)
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/backups/1/download", r.RequestURI)
+		w.Header().Set("Content-Length", "100000")
+		w.Write([]byte(payload))
+	})
+	defer s.Close()
+
+	var buf bytes.Buffer
+	var last, total int64
+	n, err := a.Download(context.Background(), RequestConfig{Method: "GET", Path: "/v1/backups/1/download"}, &buf, func(written, t int64) {
+		last, total = written, t
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100000), n)
+	assert.Equal(t, payload, buf.String())
+	assert.Equal(t, int64(100000), last)
+	assert.Equal(t, int64(100000), total)
+}
+
+func TestDownload_UnknownLength(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.(http.Flusher).Flush()
+		w.Write([]byte("data"))
+	})
+	defer s.Close()
+
+	var buf bytes.Buffer
+	var total int64
+	_, err := a.Download(context.Background(), RequestConfig{Method: "GET", Path: "/"}, &buf, func(_, t int64) {
+		total = t
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), total)
+}
+
+func TestDownload_Error(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	defer s.Close()
+
+	var buf bytes.Buffer
+	n, err := a.Download(context.Background(), RequestConfig{Method: "GET", Path: "/"}, &buf, nil)
+	assert.Zero(t, n)
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Zero(t, buf.Len())
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// dryRun logs mutating requests to w instead of sending them.
+type dryRun struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// WithDryRun stops sending mutating requests (POST, PUT, PATCH, DELETE), they're logged to w instead
+// and succeed with `204 No Content`, so typed results of any kind (structs, slices) are left as zero values.
+// Read-only requests are sent as usual.
+// Secret fields (e.g. password) are redacted from logged bodies, see `RedactBody()`.
+// Useful to offer `--dry-run` in reconciliation loops and CLIs. Nil w disables dry run.
+func WithDryRun(w io.Writer) Option {
+	return func(a *API) {
+		if w == nil {
+			a.dryRun = nil
+			return
+		}
+		a.dryRun = &dryRun{w: w}
+	}
+}
+
+// wrap returns RoundTripFunc that short-circuits mutating requests before they reach next.
+func (d *dryRun) wrap(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if !isMutating(req.Method) {
+			return next(req)
+		}
+		var body []byte
+		if req.Body != nil {
+			b, err := io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			body = b
+		}
+		d.log(req, RedactBody(body))
+
+		return &http.Response{
+			Status:        "204 No Content",
+			StatusCode:    http.StatusNoContent,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {"application/json"}, "X-Dry-Run": {"true"}},
+			Body:          http.NoBody,
+			ContentLength: 0,
+			Request:       req,
+		}, nil
+	}
+}
+
+func (d *dryRun) log(req *http.Request, body []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(body) == 0 {
+		fmt.Fprintf(d.w, "dry run: %s %s\n", req.Method, req.URL)
+		return
+	}
+	fmt.Fprintf(d.w, "dry run: %s %s %s\n", req.Method, req.URL, bytes.TrimSpace(body))
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDryRun(t *testing.T) {
+	var methods []string
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Write([]byte(`{"id":1}`))
+	})
+	defer s.Close()
+
+	var log bytes.Buffer
+	a = a.Clone(WithDryRun(&log))
+
+	// read-only requests are sent
+	v, err := Call[struct{ ID int }](context.Background(), a, RequestConfig{Method: "GET", Path: "/v1/storage/disks"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v.ID)
+
+	// mutating ones are only logged
+	v, err = Call[struct{ ID int }](context.Background(), a, RequestConfig{
+		Method: "POST",
+		Path:   "/v1/storage/disks",
+		Data:   url.Values{"size_gb": {"20"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, v.ID)
+	l, err := Call[[]struct{ ID int }](context.Background(), a, RequestConfig{Method: "PUT", Path: "/v1/storage/disks"})
+	assert.NoError(t, err)
+	assert.Empty(t, *l)
+	assert.NoError(t, a.FormRequest(context.Background(), RequestConfig{Method: "DELETE", Path: "/v1/storage/disks/1"}).Error)
+
+	assert.Equal(t, []string{"GET"}, methods)
+	assert.Equal(t, "dry run: POST "+s.URL+"/v1/storage/disks size_gb=20\n"+
+		"dry run: PUT "+s.URL+"/v1/storage/disks\n"+
+		"dry run: DELETE "+s.URL+"/v1/storage/disks/1\n", log.String())
+}
+
+func TestWithDryRun_Redacted(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {})
+	defer s.Close()
+
+	var log bytes.Buffer
+	a = a.Clone(WithDryRun(&log))
+	resp := a.FormRequest(context.Background(), RequestConfig{
+		Method: "POST",
+		Path:   "/v1/user-resource/vm",
+		Data:   url.Values{"name": {"web"}, "password": {"s3cret"}},
+	})
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, "dry run: POST "+s.URL+"/v1/user-resource/vm name=web&password=REDACTED\n", log.String())
+}
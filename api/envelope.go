@@ -0,0 +1,34 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// envelope is the `{"success": true, "data": ...}` wrapper some endpoints put around their payload
+type envelope struct {
+	Success *bool           `json:"success"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// unwrapEnvelope returns payload inside envelope, or body itself when it's not enveloped.
+// Envelope with `success: false` is turned into APIError.
+func unwrapEnvelope(statusCode int, body []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '{' || !bytes.Contains(trimmed, []byte(`"success"`)) {
+		return body, nil
+	}
+
+	var e envelope
+	if err := json.Unmarshal(trimmed, &e); err != nil || e.Success == nil {
+		return body, nil
+	}
+	if !*e.Success {
+		return body, newAPIError(statusCode, body)
+	}
+	// resources may have their own success field, only unwrap when there's data
+	if e.Data == nil {
+		return body, nil
+	}
+	return e.Data, nil
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnwrapEnvelope(t *testing.T) {
+	// not enveloped
+	b, err := unwrapEnvelope(200, []byte(`[{"id":1}]`))
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"id":1}]`, string(b))
+
+	// plain resource with its own success field
+	b, err = unwrapEnvelope(200, []byte(`{"success":true,"id":1}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"success":true,"id":1}`, string(b))
+
+	// enveloped
+	b, err = unwrapEnvelope(200, []byte(` {"success":true,"data":{"id":1}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(b))
+
+	// unsuccessful
+	_, err = unwrapEnvelope(200, []byte(`{"success":false,"code":"quota_exceeded","message":"VM quota exceeded"}`))
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 200, apiErr.StatusCode)
+	assert.Equal(t, "quota_exceeded", apiErr.Code)
+	assert.Equal(t, "VM quota exceeded", apiErr.Message)
+	assert.ErrorIs(t, err, ErrQuotaExceeded)
+}
+
+func TestFormRequest_Envelope(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":true,"data":{"name":"test"}}`))
+	})
+	defer s.Close()
+
+	var v struct {
+		Name string `json:"name"`
+	}
+	err := a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"}).Into(&v)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", v.Name)
+}
+
+func TestFormRequest_EnvelopeUnsuccessful(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":false,"message":"invalid name"}`))
+	})
+	defer s.Close()
+
+	resp := a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"})
+	assert.EqualError(t, resp.Error, `api call failed with status code=200: {"success":false,"message":"invalid name"}`)
+	assert.Equal(t, 200, resp.StatusCode)
+}
@@ -0,0 +1,204 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sentinel errors to be used with `errors.Is()` against errors returned by API calls.
+var (
+	ErrBadRequest      = errors.New("bad request")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrForbidden       = errors.New("forbidden")
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrQuotaExceeded   = errors.New("quota exceeded")
+	ErrTooManyRequests = errors.New("too many requests")
+	ErrServer          = errors.New("server error")
+)
+
+// APIError is returned when the API responded with non-success status code,
+// or with `{"success": false}` envelope in which case StatusCode may be 2xx.
+// Code and Message are parsed from the response body when available, Body always holds the raw response.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Body       []byte
+
+	request RequestInfo
+}
+
+// requestIDHeaders are response headers the request ID is taken from, first non-empty one wins
+var requestIDHeaders = []string{"X-Request-Id", "X-Correlation-Id", "Request-Id"}
+
+// RequestInfo identifies the failed call, include it in support tickets.
+// RequestID is empty when the API didn't send one.
+type RequestInfo struct {
+	Method     string
+	Path       string
+	StatusCode int
+	RequestID  string
+}
+
+func (i RequestInfo) String() string {
+	s := fmt.Sprintf("%s %s status=%d", i.Method, i.Path, i.StatusCode)
+	if i.RequestID != "" {
+		s += " request_id=" + i.RequestID
+	}
+	return s
+}
+
+// RequestInfo returns method, path, status code and request ID of the call that failed.
+func (e *APIError) RequestInfo() RequestInfo {
+	return e.request
+}
+
+// RequestInfoOf returns RequestInfo of err when it's (or wraps) *APIError.
+//
+//	if info, ok := api.RequestInfoOf(err); ok {
+//		log.Printf("call failed: %s", info)
+//	}
+func RequestInfoOf(err error) (RequestInfo, bool) {
+	var e *APIError
+	if errors.As(err, &e) {
+		return e.request, true
+	}
+	return RequestInfo{}, false
+}
+
+// withRequestInfo attaches request details of res to err when it's *APIError.
+func withRequestInfo(err error, res *http.Response) error {
+	var e *APIError
+	if res == nil || !errors.As(err, &e) {
+		return err
+	}
+	e.request = RequestInfo{StatusCode: res.StatusCode}
+	if res.Request != nil {
+		e.request.Method = res.Request.Method
+		e.request.Path = res.Request.URL.Path
+	}
+	for _, h := range requestIDHeaders {
+		if id := res.Header.Get(h); id != "" {
+			e.request.RequestID = id
+			break
+		}
+	}
+	return err
+}
+
+// Error keeps the raw body in the message since error formats vary between endpoints.
+func (e *APIError) Error() string {
+	if len(e.Body) == 0 {
+		return fmt.Sprintf("api call failed with status code=%d", e.StatusCode)
+	}
+	return fmt.Sprintf("api call failed with status code=%d: %s", e.StatusCode, e.Body)
+}
+
+// Is makes APIError comparable to the sentinel errors above.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrQuotaExceeded:
+		return e.StatusCode == http.StatusPaymentRequired ||
+			strings.Contains(strings.ToLower(e.Code), "quota") ||
+			strings.Contains(strings.ToLower(e.Message), "quota")
+	case ErrTooManyRequests:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+// ThrottledError is returned when the API responded with 429, or with 503 carrying Retry-After header.
+// RetryAfter is parsed from the header and is zero when it's missing or invalid.
+// It wraps *APIError so sentinel errors (e.g. `ErrTooManyRequests`) still match.
+type ThrottledError struct {
+	*APIError
+	RetryAfter time.Duration
+}
+
+func (e *ThrottledError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s (retry after %s)", e.APIError.Error(), e.RetryAfter)
+	}
+	return e.APIError.Error()
+}
+
+func (e *ThrottledError) Unwrap() error {
+	return e.APIError
+}
+
+// newResponseError creates error for non-success response, picking `ThrottledError` for throttled ones.
+func newResponseError(res *http.Response, body []byte) error {
+	e := newAPIError(res.StatusCode, body)
+	withRequestInfo(e, res)
+	retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After"), time.Now())
+	if res.StatusCode == http.StatusTooManyRequests || (res.StatusCode == http.StatusServiceUnavailable && ok) {
+		return &ThrottledError{APIError: e, RetryAfter: retryAfter}
+	}
+	return e
+}
+
+// parseRetryAfter parses Retry-After header which is either delay in seconds or HTTP date.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	t, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// newAPIError creates APIError and tries to pick error code and message from the JSON body.
+func newAPIError(statusCode int, body []byte) *APIError {
+	e := &APIError{StatusCode: statusCode, Body: body}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		e.Code = stringField(payload, "code", "error_code")
+		e.Message = stringField(payload, "message", "error", "detail")
+	}
+	return e
+}
+
+// stringField returns the first non-empty scalar value of given keys.
+func stringField(m map[string]interface{}, keys ...string) string {
+	for _, k := range keys {
+		switch v := m[k].(type) {
+		case string:
+			if v != "" {
+				return v
+			}
+		case float64, bool:
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
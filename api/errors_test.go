@@ -0,0 +1,149 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPIError(t *testing.T) {
+	e := newAPIError(404, []byte(`{"code":"NotFound","message":"disk not found"}`))
+	assert.Equal(t, 404, e.StatusCode)
+	assert.Equal(t, "NotFound", e.Code)
+	assert.Equal(t, "disk not found", e.Message)
+	assert.Equal(t, `api call failed with status code=404: {"code":"NotFound","message":"disk not found"}`, e.Error())
+
+	// alternative keys
+	e = newAPIError(400, []byte(`{"error_code":123,"error":"invalid size"}`))
+	assert.Equal(t, "123", e.Code)
+	assert.Equal(t, "invalid size", e.Message)
+
+	// non-json body
+	e = newAPIError(500, []byte("Internal Server Error"))
+	assert.Empty(t, e.Code)
+	assert.Empty(t, e.Message)
+
+	// no body
+	e = newAPIError(502, nil)
+	assert.Equal(t, "api call failed with status code=502", e.Error())
+}
+
+func TestAPIError_Is(t *testing.T) {
+	assert.ErrorIs(t, newAPIError(400, nil), ErrBadRequest)
+	assert.ErrorIs(t, newAPIError(401, nil), ErrUnauthorized)
+	assert.ErrorIs(t, newAPIError(403, nil), ErrForbidden)
+	assert.ErrorIs(t, newAPIError(404, nil), ErrNotFound)
+	assert.ErrorIs(t, newAPIError(409, nil), ErrConflict)
+	assert.ErrorIs(t, newAPIError(402, nil), ErrQuotaExceeded)
+	assert.ErrorIs(t, newAPIError(400, []byte(`{"code":"QuotaExceeded"}`)), ErrQuotaExceeded)
+	assert.ErrorIs(t, newAPIError(429, nil), ErrTooManyRequests)
+	assert.ErrorIs(t, newAPIError(503, nil), ErrServer)
+	assert.NotErrorIs(t, newAPIError(404, nil), ErrQuotaExceeded)
+}
+
+func TestFormRequest_APIError(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"NotFound","message":"not found"}`))
+	})
+	defer s.Close()
+
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.ErrorIs(t, resp.Error, ErrNotFound)
+
+	var apiErr *APIError
+	assert.True(t, errors.As(resp.Error, &apiErr))
+	assert.Equal(t, "NotFound", apiErr.Code)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	d, ok := parseRetryAfter("5", now)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, d)
+
+	d, ok = parseRetryAfter(now.Add(time.Minute).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, d)
+
+	d, ok = parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), d)
+
+	_, ok = parseRetryAfter("", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("-1", now)
+	assert.False(t, ok)
+	_, ok = parseRetryAfter("soon", now)
+	assert.False(t, ok)
+}
+
+func TestFormRequest_ThrottledError(t *testing.T) {
+	status, retryAfter := http.StatusTooManyRequests, "7"
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(status)
+	})
+	defer s.Close()
+
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	var throttled *ThrottledError
+	assert.True(t, errors.As(resp.Error, &throttled))
+	assert.Equal(t, 7*time.Second, throttled.RetryAfter)
+	assert.ErrorIs(t, resp.Error, ErrTooManyRequests)
+	assert.EqualError(t, resp.Error, "api call failed with status code=429 (retry after 7s)")
+
+	var apiErr *APIError
+	assert.True(t, errors.As(resp.Error, &apiErr))
+
+	// 503 is throttled only with Retry-After
+	status = http.StatusServiceUnavailable
+	resp = c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.True(t, errors.As(resp.Error, &throttled))
+
+	retryAfter = ""
+	resp = c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.False(t, errors.As(resp.Error, &throttled))
+	assert.ErrorIs(t, resp.Error, ErrServer)
+}
+
+func TestAPIError_RequestInfo(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer s.Close()
+
+	err := a.FormRequest(context.Background(), RequestConfig{Method: "get", Path: "/v1/storage/disks/abc", Query: url.Values{"a": {"1"}}}).Error
+	info, ok := RequestInfoOf(err)
+	assert.True(t, ok)
+	assert.Equal(t, RequestInfo{Method: "GET", Path: "/v1/storage/disks/abc", StatusCode: 404, RequestID: "req-123"}, info)
+	assert.Equal(t, "GET /v1/storage/disks/abc status=404 request_id=req-123", info.String())
+
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, info, apiErr.RequestInfo())
+}
+
+func TestAPIError_RequestInfoEnvelope(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"success":false,"message":"quota exceeded"}`))
+	})
+	defer s.Close()
+
+	err := a.FormRequest(context.Background(), RequestConfig{Method: "POST", Path: "/v1/storage/disks"}).Error
+	info, ok := RequestInfoOf(err)
+	assert.True(t, ok)
+	assert.Equal(t, "POST /v1/storage/disks status=200", info.String())
+
+	_, ok = RequestInfoOf(errors.New("network error"))
+	assert.False(t, ok)
+}
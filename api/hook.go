@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"regexp"
+	"time"
+)
+
+// CallInfo describes a single API call made by FormRequest or JSONRequest.
+// Path is a template where resource identifiers are replaced by placeholders,
+// e.g. `/v1/storage/disks/{uuid}`, so it's safe to be used as span name or metric label.
+type CallInfo struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Retries    int
+	Duration   time.Duration
+	Err        error
+}
+
+// Hook observes API calls, e.g. to create trace spans or collect metrics.
+// StatusCode, Retries, Duration and Err are only set when AfterCall is called.
+type Hook interface {
+	// BeforeCall is called before the first attempt, the returned context is used for the rest of the call.
+	BeforeCall(ctx context.Context, info CallInfo) context.Context
+	// AfterCall is called once the call is finished, after all retries.
+	AfterCall(ctx context.Context, info CallInfo)
+}
+
+// WithHook registers hooks to be notified about every API call.
+func WithHook(hooks ...Hook) Option {
+	return func(a *API) {
+		a.hooks = append(a.hooks, hooks...)
+	}
+}
+
+var (
+	uuidSegment    = regexp.MustCompile(`/[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}(/|$)`)
+	addressSegment = regexp.MustCompile(`/\d{1,3}\.\d{1,3}\.\d{1,3}\.\d{1,3}(/|$)`)
+	numberSegment  = regexp.MustCompile(`/\d+(/|$)`)
+)
+
+// pathTemplate replaces UUIDs, IP addresses and numeric IDs in path with placeholders.
+func pathTemplate(path string) string {
+	// applied twice since adjacent segments share the slash
+	for i := 0; i < 2; i++ {
+		path = uuidSegment.ReplaceAllString(path, "/{uuid}$1")
+		path = addressSegment.ReplaceAllString(path, "/{address}$1")
+		path = numberSegment.ReplaceAllString(path, "/{id}$1")
+	}
+	return path
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ctxKey string
+
+type recordingHook struct {
+	before []CallInfo
+	after  []CallInfo
+	ctxVal interface{}
+}
+
+func (h *recordingHook) BeforeCall(ctx context.Context, info CallInfo) context.Context {
+	h.before = append(h.before, info)
+	return context.WithValue(ctx, ctxKey("hook"), "set")
+}
+
+func (h *recordingHook) AfterCall(ctx context.Context, info CallInfo) {
+	h.ctxVal = ctx.Value(ctxKey("hook"))
+	h.after = append(h.after, info)
+}
+
+func TestPathTemplate(t *testing.T) {
+	assert.Equal(t, "/v1/storage/disks", pathTemplate("/v1/storage/disks"))
+	assert.Equal(t, "/v1/storage/disks/{uuid}", pathTemplate("/v1/storage/disks/4e5eadd3-8b11-4c34-812a-2cf97120b628"))
+	assert.Equal(t, "/v1/jkt01/network/ip_addresses/{address}/assign", pathTemplate("/v1/jkt01/network/ip_addresses/1.2.3.4/assign"))
+	assert.Equal(t, "/v1/payment/billing_account/{id}", pathTemplate("/v1/payment/billing_account/123"))
+	assert.Equal(t,
+		"/v1/jkt01/network/load_balancers/{uuid}/targets/{uuid}",
+		pathTemplate("/v1/jkt01/network/load_balancers/4e5eadd3-8b11-4c34-812a-2cf97120b628/targets/1a2d6a39-5bd4-4cb0-9bd7-0b1a4b6d0c7e"),
+	)
+}
+
+func TestWithHook(t *testing.T) {
+	calls := 0
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("OK"))
+	})
+	defer s.Close()
+
+	h := &recordingHook{}
+	WithRetry(1, time.Millisecond)(c)
+	WithHook(h)(c)
+
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "get", Path: "/v1/payment/billing_account/123"})
+	assert.NoError(t, resp.Error)
+
+	assert.Len(t, h.before, 1)
+	assert.Equal(t, "GET", h.before[0].Method)
+	assert.Equal(t, "/v1/payment/billing_account/{id}", h.before[0].Path)
+
+	assert.Len(t, h.after, 1)
+	assert.Equal(t, http.StatusOK, h.after[0].StatusCode)
+	assert.Equal(t, 1, h.after[0].Retries)
+	assert.Greater(t, h.after[0].Duration, time.Duration(0))
+	assert.NoError(t, h.after[0].Err)
+	assert.Equal(t, "set", h.ctxVal)
+}
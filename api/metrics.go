@@ -0,0 +1,31 @@
+package api
+
+import (
+	"context"
+	"time"
+)
+
+// MetricsRecorder receives measurement of every finished API call, e.g. to expose them to Prometheus.
+// Path is a template (see `CallInfo`) so it can be used as metric label safely.
+// StatusCode is 0 when the call failed without response, such as network errors.
+type MetricsRecorder interface {
+	RecordCall(method, path string, statusCode int, duration time.Duration, err error)
+}
+
+// WithMetrics reports every API call to r.
+func WithMetrics(r MetricsRecorder) Option {
+	return WithHook(metricsHook{r})
+}
+
+// metricsHook adapts MetricsRecorder to Hook.
+type metricsHook struct {
+	recorder MetricsRecorder
+}
+
+func (h metricsHook) BeforeCall(ctx context.Context, info CallInfo) context.Context {
+	return ctx
+}
+
+func (h metricsHook) AfterCall(ctx context.Context, info CallInfo) {
+	h.recorder.RecordCall(info.Method, info.Path, info.StatusCode, info.Duration, info.Err)
+}
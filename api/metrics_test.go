@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type call struct {
+	method     string
+	path       string
+	statusCode int
+	err        error
+}
+
+type recorder struct {
+	calls []call
+}
+
+func (r *recorder) RecordCall(method, path string, statusCode int, duration time.Duration, err error) {
+	r.calls = append(r.calls, call{method, path, statusCode, err})
+}
+
+func TestWithMetrics(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+	})
+	defer s.Close()
+
+	rec := &recorder{}
+	WithMetrics(rec)(c)
+
+	c.JSONRequest(context.Background(), RequestConfig{Method: "DELETE", Path: "/v1/jkt01/network/ip_addresses/1.2.3.4"})
+
+	assert.Len(t, rec.calls, 1)
+	assert.Equal(t, "DELETE", rec.calls[0].method)
+	assert.Equal(t, "/v1/jkt01/network/ip_addresses/{address}", rec.calls[0].path)
+	assert.Equal(t, http.StatusConflict, rec.calls[0].statusCode)
+	assert.ErrorIs(t, rec.calls[0].err, ErrConflict)
+}
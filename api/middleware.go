@@ -0,0 +1,40 @@
+package api
+
+import "net/http"
+
+// RoundTripFunc sends a single HTTP request, `http.Client.Do` is the innermost one.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps RoundTripFunc to inspect or mutate requests and responses,
+// e.g. logging, metrics or injecting headers.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use appends middlewares to the chain, the first one registered is the outermost.
+// Middlewares are called on every attempt when retry is enabled.
+func (a *API) Use(mw ...Middleware) {
+	a.middlewares = append(a.middlewares, mw...)
+}
+
+// WithMiddleware registers middlewares when creating API, see `Use()`.
+func WithMiddleware(mw ...Middleware) Option {
+	return func(a *API) {
+		a.Use(mw...)
+	}
+}
+
+// roundTrip returns HTTPClient.Do wrapped by all registered middlewares.
+// Debug logger, if enabled, is the innermost so it sees the final request.
+func (a *API) roundTrip() RoundTripFunc {
+	// decompressed first so debug logger and middlewares see decoded body
+	rt := decompress(a.HTTPClient.Do)
+	if a.dryRun != nil {
+		rt = a.dryRun.wrap(rt)
+	}
+	if a.debug != nil {
+		rt = a.debug.wrap(rt)
+	}
+	for i := len(a.middlewares) - 1; i >= 0; i-- {
+		rt = a.middlewares[i](rt)
+	}
+	return rt
+}
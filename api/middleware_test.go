@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUse(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "abc", r.Header.Get("X-Trace-Id"))
+		w.Write([]byte("OK"))
+	})
+	defer s.Close()
+
+	var calls []string
+	trace := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, "trace")
+			req.Header.Set("X-Trace-Id", "abc")
+			return next(req)
+		}
+	}
+	logger := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, "log:before")
+			res, err := next(req)
+			calls = append(calls, "log:after")
+			return res, err
+		}
+	}
+	c.Use(logger, trace)
+
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, []string{"log:before", "trace", "log:after"}, calls)
+}
+
+func TestWithMiddleware(t *testing.T) {
+	mw := func(next RoundTripFunc) RoundTripFunc { return next }
+	a := New("https://api.warren.io", "secret", WithMiddleware(mw, mw))
+	assert.Len(t, a.middlewares, 2)
+}
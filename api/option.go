@@ -0,0 +1,60 @@
+package api
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures optional behaviours of API, see `New()`.
+type Option func(*API)
+
+// WithRetry retries transient failures (429, 5xx and network errors) up to max times.
+// Delay between attempts grows exponentially from base up to 30s, with jitter applied.
+func WithRetry(max int, base time.Duration) Option {
+	return func(a *API) {
+		a.retry = retryPolicy{max: max, base: base}
+	}
+}
+
+// WithRateLimit limits outgoing requests to rps requests per second, allowing bursts of up to burst requests.
+// Requests exceeding the limit wait for their turn (or until the context is done), retries are counted too.
+func WithRateLimit(rps float64, burst int) Option {
+	return func(a *API) {
+		if rps <= 0 {
+			a.limiter = nil
+			return
+		}
+		a.limiter = newRateLimiter(rps, burst)
+	}
+}
+
+// WithTimeout sets default timeout for every call, including retries.
+// Use `RequestConfig.Timeout` to override it for specific calls.
+func WithTimeout(d time.Duration) Option {
+	return func(a *API) {
+		a.timeout = d
+	}
+}
+
+// WithAPIKey sets API key, mostly useful with `API.Clone()`. The key is used as is, even by `Default`
+// which otherwise reads it from the environment.
+func WithAPIKey(key string) Option {
+	return func(a *API) {
+		a.APIKey = key
+		a.envKey = ""
+	}
+}
+
+// WithBaseURL sets API base URL, mostly useful with `API.Clone()`.
+func WithBaseURL(url string) Option {
+	return func(a *API) {
+		a.BaseURL = url
+	}
+}
+
+// WithHTTPClient sets HTTP client used to send requests, `http.DefaultClient` is used by default.
+func WithHTTPClient(c *http.Client) Option {
+	return func(a *API) {
+		a.HTTPClient = c
+	}
+}
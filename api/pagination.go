@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"reflect"
+)
+
+// DefaultPageLimit is number of items per page used when iterator limit is not set.
+const DefaultPageLimit = 100
+
+// PageFunc fetches a single page of items, page number starts from 1.
+type PageFunc[T any] func(ctx context.Context, page, limit int) ([]T, error)
+
+// Iterator walks through paginated list endpoint, fetching the next page only when needed.
+// A page with less than limit items is considered the last one, so is a page equal to the previous one
+// as returned by endpoint that ignores pagination and always responds with the same items.
+//
+//	it := c.ListDisksIterator(50)
+//	for it.Next(ctx) {
+//		disk := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	fetch PageFunc[T]
+	limit int
+	page  int
+	items []T
+	prev  []T
+	cur   T
+	err   error
+	last  bool
+}
+
+// NewIterator creates Iterator that fetches pages using fetch with limit items per page.
+func NewIterator[T any](fetch PageFunc[T], limit int) *Iterator[T] {
+	if limit <= 0 {
+		limit = DefaultPageLimit
+	}
+	return &Iterator[T]{fetch: fetch, limit: limit}
+}
+
+// Next advances to the next item, fetching the next page if necessary.
+// It returns false when all items have been consumed or an error occurred, check `Err()` to tell.
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	for len(it.items) == 0 {
+		if it.last {
+			return false
+		}
+		it.page++
+		items, err := it.fetch(ctx, it.page, it.limit)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		// endpoint that ignores pagination returns everything at once, or the same page again
+		if it.page > 1 && reflect.DeepEqual(items, it.prev) {
+			it.last = true
+			continue
+		}
+		it.last = len(items) < it.limit || len(items) > it.limit
+		it.items, it.prev = items, items
+	}
+	it.cur, it.items = it.items[0], it.items[1:]
+	return true
+}
+
+// Item returns the current item.
+func (it *Iterator[T]) Item() T {
+	return it.cur
+}
+
+// Err returns error that stopped the iteration, if any.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// ForEach calls fn for every remaining item, stops at the first error returned by fn or while fetching.
+func (it *Iterator[T]) ForEach(ctx context.Context, fn func(T) error) error {
+	for it.Next(ctx) {
+		if err := fn(it.Item()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
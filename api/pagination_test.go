@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pages returns PageFunc serving items in pages and records requested pages
+func pages(items []int, requested *[]int) PageFunc[int] {
+	return func(ctx context.Context, page, limit int) ([]int, error) {
+		*requested = append(*requested, page)
+		start := (page - 1) * limit
+		if start >= len(items) {
+			return []int{}, nil
+		}
+		end := start + limit
+		if end > len(items) {
+			end = len(items)
+		}
+		return items[start:end], nil
+	}
+}
+
+func TestIterator(t *testing.T) {
+	var requested []int
+	it := NewIterator(pages([]int{1, 2, 3, 4, 5}, &requested), 2)
+
+	var got []int
+	for it.Next(context.Background()) {
+		got = append(got, it.Item())
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, got)
+	assert.Equal(t, []int{1, 2, 3}, requested)
+}
+
+func TestIterator_ExactPages(t *testing.T) {
+	var requested []int
+	it := NewIterator(pages([]int{1, 2, 3, 4}, &requested), 2)
+
+	var got []int
+	it.ForEach(context.Background(), func(i int) error {
+		got = append(got, i)
+		return nil
+	})
+	assert.Equal(t, []int{1, 2, 3, 4}, got)
+	assert.Equal(t, []int{1, 2, 3}, requested)
+}
+
+func TestIterator_PaginationIgnored(t *testing.T) {
+	calls := 0
+	it := NewIterator(func(ctx context.Context, page, limit int) ([]int, error) {
+		calls++
+		return []int{1, 2, 3}, nil
+	}, 2)
+
+	var got []int
+	it.ForEach(context.Background(), func(i int) error {
+		got = append(got, i)
+		return nil
+	})
+	assert.Equal(t, []int{1, 2, 3}, got)
+	assert.Equal(t, 1, calls)
+}
+
+func TestIterator_PaginationIgnoredExactLimit(t *testing.T) {
+	calls := 0
+	it := NewIterator(func(ctx context.Context, page, limit int) ([]int, error) {
+		calls++
+		return []int{1, 2}, nil
+	}, 2)
+
+	var got []int
+	it.ForEach(context.Background(), func(i int) error {
+		got = append(got, i)
+		return nil
+	})
+	assert.Equal(t, []int{1, 2}, got)
+	assert.Equal(t, 2, calls)
+}
+
+func TestIterator_Error(t *testing.T) {
+	it := NewIterator(func(ctx context.Context, page, limit int) ([]int, error) {
+		assert.Equal(t, DefaultPageLimit, limit)
+		return nil, errors.New("failed")
+	}, 0)
+	assert.False(t, it.Next(context.Background()))
+	assert.EqualError(t, it.Err(), "failed")
+}
+
+func TestIterator_ForEachStop(t *testing.T) {
+	var requested []int
+	it := NewIterator(pages([]int{1, 2, 3}, &requested), 2)
+
+	err := it.ForEach(context.Background(), func(i int) error {
+		return errors.New("stop")
+	})
+	assert.EqualError(t, err, "stop")
+}
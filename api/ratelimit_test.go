@@ -0,0 +1,93 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	a := New("https://api.warren.io", "secret", WithRateLimit(10, 5))
+	assert.Equal(t, float64(10), a.limiter.rate)
+	assert.Equal(t, float64(5), a.limiter.burst)
+
+	// disabled
+	a = New("https://api.warren.io", "secret", WithRateLimit(0, 5))
+	assert.Nil(t, a.limiter)
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	l := newRateLimiter(1, 2)
+
+	// burst
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+
+	// bucket is empty, next token in ~1s
+	d := l.reserve()
+	assert.Greater(t, d, 900*time.Millisecond)
+	assert.LessOrEqual(t, d, time.Second)
+}
+
+func TestRateLimiter_wait(t *testing.T) {
+	l := newRateLimiter(100, 1)
+	assert.NoError(t, l.wait(context.Background()))
+
+	start := time.Now()
+	assert.NoError(t, l.wait(context.Background()))
+	assert.GreaterOrEqual(t, time.Since(start), 5*time.Millisecond)
+
+	// context done before token available
+	l = newRateLimiter(0.001, 1)
+	l.reserve()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.wait(ctx), context.DeadlineExceeded)
+}
+
+func TestFormRequest_RateLimited(t *testing.T) {
+	calls := 0
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte("OK"))
+	})
+	defer s.Close()
+	WithRateLimit(0.001, 1)(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	cfg := RequestConfig{Method: "GET", Path: "/test"}
+	assert.NoError(t, c.FormRequest(ctx, cfg).Error)
+	assert.ErrorIs(t, c.FormRequest(ctx, cfg).Error, context.DeadlineExceeded)
+	assert.Equal(t, 1, calls)
+}
+
+// readSpy records whether the file has been read
+type readSpy struct{ read bool }
+
+func (r *readSpy) Read(p []byte) (int, error) {
+	r.read = true
+	return 0, io.EOF
+}
+
+func TestUploadRequest_RateLimited(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {})
+	defer s.Close()
+	WithRateLimit(0.001, 1)(c)
+	c.limiter.reserve()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	file := &readSpy{}
+	cfg := RequestConfig{Method: "POST", Path: "/test", Files: []FileField{{Field: "file", FileName: "a.txt", Reader: file}}}
+	assert.ErrorIs(t, c.UploadRequest(ctx, cfg, nil).Error, context.DeadlineExceeded)
+	// multipart body is never started
+	time.Sleep(10 * time.Millisecond)
+	assert.False(t, file.read)
+}
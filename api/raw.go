@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"net/http"
+)
+
+type rawKey struct{}
+
+// RawResponse is the response exactly as received from the API, before the envelope is unwrapped
+// and the body decoded into typed result. Useful to inspect undocumented fields while debugging.
+type RawResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// CaptureRaw returns ctx that makes every typed method called with it fill raw with the API response,
+// including failed ones. Methods sending more than one request (e.g. waiters) leave the last response.
+//
+//	var raw api.RawResponse
+//	disk, err := c.GetDisk(api.CaptureRaw(ctx, &raw), id)
+//	fmt.Println(raw.StatusCode, raw.Header.Get("X-Request-Id"), string(raw.Body))
+func CaptureRaw(ctx context.Context, raw *RawResponse) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, rawKey{}, raw)
+}
+
+// captureRaw copies resp with given body into RawResponse attached to ctx, if any.
+func captureRaw(ctx context.Context, resp *ClientResponse, body []byte) {
+	if ctx == nil {
+		return
+	}
+	raw, ok := ctx.Value(rawKey{}).(*RawResponse)
+	if !ok || raw == nil {
+		return
+	}
+	*raw = RawResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+	}
+}
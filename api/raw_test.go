@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureRaw(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc")
+		w.Write([]byte(`{"success":true,"data":{"id":1,"undocumented":"x"}}`))
+	})
+	defer s.Close()
+
+	var raw RawResponse
+	v, err := Call[struct{ ID int }](CaptureRaw(context.Background(), &raw), a, RequestConfig{Method: "GET", Path: "/"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v.ID)
+	assert.Equal(t, http.StatusOK, raw.StatusCode)
+	assert.Equal(t, "abc", raw.Header.Get("X-Request-Id"))
+	// body is kept as received, envelope included
+	assert.Equal(t, `{"success":true,"data":{"id":1,"undocumented":"x"}}`, string(raw.Body))
+}
+
+func TestCaptureRaw_Error(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"message":"disk not found"}`))
+	})
+	defer s.Close()
+
+	var raw RawResponse
+	err := a.FormRequest(CaptureRaw(nil, &raw), RequestConfig{Method: "GET", Path: "/"}).Error
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.Equal(t, http.StatusNotFound, raw.StatusCode)
+	assert.Equal(t, `{"message":"disk not found"}`, string(raw.Body))
+}
+
+func TestClientResponse_Header(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc")
+	})
+	defer s.Close()
+
+	resp := a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"})
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, "abc", resp.Header.Get("X-Request-Id"))
+}
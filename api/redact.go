@@ -0,0 +1,83 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces values of secret fields in debug output, dry run log and recorded fixtures.
+const Redacted = "REDACTED"
+
+// SecretFields are body fields (form or JSON, at any depth) which values are never logged, see `RedactBody()`.
+var SecretFields = []string{"password", "public_key", "cloud_init", "token", "secret", "secretKey"}
+
+// RedactBody returns copy of form or JSON encoded body with values of `SecretFields` replaced by `Redacted`.
+// Body without secret fields, or in other formats, is returned as is.
+func RedactBody(body []byte) []byte {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 {
+		return body
+	}
+	if trimmed[0] == '{' || trimmed[0] == '[' {
+		var v interface{}
+		if err := json.Unmarshal(trimmed, &v); err != nil || !redactJSON(v) {
+			return body
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return body
+		}
+		return b
+	}
+
+	form, err := url.ParseQuery(string(trimmed))
+	if err != nil {
+		return body
+	}
+	redacted := false
+	for k, vs := range form {
+		if !isSecretField(k) {
+			continue
+		}
+		for i := range vs {
+			vs[i] = Redacted
+		}
+		redacted = true
+	}
+	if !redacted {
+		return body
+	}
+	return []byte(form.Encode())
+}
+
+// redactJSON replaces secret fields of decoded JSON v in place, reporting whether any was found.
+func redactJSON(v interface{}) bool {
+	redacted := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, fv := range v {
+			if isSecretField(k) && fv != nil {
+				v[k] = Redacted
+				redacted = true
+				continue
+			}
+			redacted = redactJSON(fv) || redacted
+		}
+	case []interface{}:
+		for _, item := range v {
+			redacted = redactJSON(item) || redacted
+		}
+	}
+	return redacted
+}
+
+func isSecretField(name string) bool {
+	for _, f := range SecretFields {
+		if strings.EqualFold(name, f) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{"", ""},
+		{"name=test&size_gb=20", "name=test&size_gb=20"},
+		{"name=test&password=s3cret&public_key=ssh-rsa+AAA", "name=test&password=REDACTED&public_key=REDACTED"},
+		{`{"name": "test"}`, `{"name": "test"}`},
+		{`{"name":"test","password":"s3cret"}`, `{"name":"test","password":"REDACTED"}`},
+		{`[{"uuid":"1","token":"abc"},{"secretKey":"xyz"}]`, `[{"token":"REDACTED","uuid":"1"},{"secretKey":"REDACTED"}]`},
+		{"not json {", "not json {"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, string(RedactBody([]byte(tt.body))), tt.body)
+	}
+}
@@ -5,34 +5,126 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// RequestConfig describes a single API call.
+// Method is any HTTP method (GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS, ...), empty means GET.
+// Data is sent form-encoded while JSON accepts any value that can be marshaled by `encoding/json`,
+// only one of them can be set. Data of GET, HEAD and OPTIONS requests is sent in the query string instead.
+// Page and Limit are added to the query string when set, for endpoints that support pagination.
+// Timeout overrides API's default timeout (see `WithTimeout()`) for this call only.
+// Files, when set, makes the request multipart-encoded with Data sent as regular fields, see `UploadRequest()`.
+// Headers are extra headers sent with this call only (e.g. trace IDs), they can't override headers set by the client.
+// IdempotencyKey is sent as `Idempotency-Key` header so that retried request is not applied twice,
+// it's generated for mutating (non GET/HEAD/OPTIONS) request when empty and stays the same across retries.
+// Cacheable marks GET call which response can be cached, it has no effect unless cache is set, see `WithCache()`.
 type RequestConfig struct {
-	Method string
-	Path   string
-	Query  url.Values
-	Data   url.Values
-	JSON   map[string]interface{}
+	Method  string
+	Path    string
+	Query   url.Values
+	Data    url.Values
+	JSON    interface{}
+	Files   []FileField
+	Page    int
+	Limit   int
+	Timeout time.Duration
+	Headers http.Header
+
+	IdempotencyKey string
+	Cacheable      bool
+
+	progress ProgressFunc
+}
+
+// timeout returns Timeout if set, otherwise the given default.
+func (r RequestConfig) timeout(def time.Duration) time.Duration {
+	if r.Timeout > 0 {
+		return r.Timeout
+	}
+	return def
+}
+
+// method returns upper-cased Method, empty means GET.
+func (r RequestConfig) method() string {
+	if r.Method == "" {
+		return http.MethodGet
+	}
+	return strings.ToUpper(r.Method)
+}
+
+// contentType returns content type matching the payload, JSON when it's set or form otherwise.
+func (r RequestConfig) contentType() string {
+	if r.JSON != nil {
+		return "application/json"
+	}
+	return "application/x-www-form-urlencoded"
+}
+
+// mutating tells whether request changes resources on the server side.
+func (r RequestConfig) mutating() bool {
+	return isMutating(r.Method)
 }
 
-// URL returns full request URL composed from baseURL, Path and Query field.
+// dataInQuery tells whether Data is sent in the query string, see `RequestConfig`.
+func (r RequestConfig) dataInQuery() bool {
+	return r.Data != nil && len(r.Files) == 0 && !r.mutating()
+}
+
+// isMutating reports whether requests of given HTTP method change resources, empty method means GET.
+func isMutating(method string) bool {
+	switch strings.ToUpper(method) {
+	case "", "GET", "HEAD", "OPTIONS":
+		return false
+	}
+	return true
+}
+
+// URL returns full request URL composed from baseURL, Path, Query, Page and Limit field.
 func (r RequestConfig) url(baseURL string) string {
 	url := fmt.Sprintf("%s/%s", baseURL, strings.TrimLeft(r.Path, "/"))
-	if r.Query == nil {
+	q := r.query()
+	if len(q) == 0 {
 		return url
 	}
-	qs := r.Query.Encode()
+	qs := q.Encode()
 	return fmt.Sprintf("%s?%s", url, qs)
 }
 
+// query returns copy of Query with pagination params (and Data, see `dataInQuery()`) added,
+// Query itself is never modified.
+func (r RequestConfig) query() url.Values {
+	if r.Page <= 0 && r.Limit <= 0 && !r.dataInQuery() {
+		return r.Query
+	}
+	q := url.Values{}
+	for k, v := range r.Query {
+		q[k] = v
+	}
+	if r.dataInQuery() {
+		for k, v := range r.Data {
+			q[k] = append(append([]string(nil), q[k]...), v...)
+		}
+	}
+	if r.Page > 0 {
+		q.Set("page", strconv.Itoa(r.Page))
+	}
+	if r.Limit > 0 {
+		q.Set("limit", strconv.Itoa(r.Limit))
+	}
+	return q
+}
+
 // body returns io.Reader either from Data or Json field
 func (r RequestConfig) body() (io.Reader, error) {
 	if r.Data != nil && r.JSON != nil {
 		return nil, errors.New("data and json can not be set at the same time")
 	}
-	if r.Data != nil {
+	if r.Data != nil && !r.dataInQuery() {
 		return strings.NewReader(r.Data.Encode()), nil
 	}
 	if r.JSON != nil {
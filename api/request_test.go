@@ -1,6 +1,7 @@
 package api
 
 import (
+	"io"
 	"net/url"
 	"testing"
 
@@ -32,3 +33,73 @@ func TestRequestConfig_url(t *testing.T) {
 	}
 	assert.Equal(t, "https://example.com/some/path?name=test", cfg.url("https://example.com"))
 }
+
+func TestRequestConfig_body(t *testing.T) {
+	// no body
+	cfg := RequestConfig{}
+	b, err := cfg.body()
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	// both data and json set
+	cfg = RequestConfig{Data: url.Values{}, JSON: map[string]interface{}{}}
+	_, err = cfg.body()
+	assert.Error(t, err)
+
+	// json from struct
+	cfg = RequestConfig{JSON: struct {
+		Name string `json:"name"`
+	}{Name: "test"}}
+	b, err = cfg.body()
+	assert.NoError(t, err)
+	data, _ := io.ReadAll(b)
+	assert.Equal(t, `{"name":"test"}`, string(data))
+
+	// unsupported json value
+	cfg = RequestConfig{JSON: make(chan int)}
+	_, err = cfg.body()
+	assert.Error(t, err)
+}
+
+func TestRequestConfig_url_Pagination(t *testing.T) {
+	q := url.Values{}
+	q.Add("name", "test")
+
+	cfg := RequestConfig{
+		Path:  "/some/path",
+		Query: q,
+		Page:  2,
+		Limit: 50,
+	}
+	assert.Equal(t, "https://example.com/some/path?limit=50&name=test&page=2", cfg.url("https://example.com"))
+	// original query untouched
+	assert.Equal(t, url.Values{"name": []string{"test"}}, q)
+
+	cfg = RequestConfig{Path: "/some/path", Page: 1}
+	assert.Equal(t, "https://example.com/some/path?page=1", cfg.url("https://example.com"))
+}
+
+func TestRequestConfig_mutating(t *testing.T) {
+	assert.False(t, RequestConfig{}.mutating())
+	assert.False(t, RequestConfig{Method: "get"}.mutating())
+	assert.False(t, RequestConfig{Method: "HEAD"}.mutating())
+	assert.True(t, RequestConfig{Method: "post"}.mutating())
+	assert.True(t, RequestConfig{Method: "DELETE"}.mutating())
+}
+
+func TestRequestConfig_dataInQuery(t *testing.T) {
+	d := url.Values{"uuid": {"abc"}}
+
+	cfg := RequestConfig{Method: "get", Path: "/vm", Query: url.Values{"a": {"1"}}, Data: d}
+	assert.Equal(t, "https://example.com/vm?a=1&uuid=abc", cfg.url("https://example.com"))
+	b, err := cfg.body()
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	cfg = RequestConfig{Method: "PUT", Path: "/vm", Data: d}
+	assert.Equal(t, "https://example.com/vm", cfg.url("https://example.com"))
+	b, err = cfg.body()
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(b)
+	assert.Equal(t, "uuid=abc", string(body))
+}
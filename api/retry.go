@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// maxBackoff caps the delay between attempts, however many retries are allowed.
+const maxBackoff = 30 * time.Second
+
+// retryPolicy holds retry configuration, zero value means no retry.
+type retryPolicy struct {
+	max  int
+	base time.Duration
+}
+
+// backoff returns delay before the next attempt: base * 2^attempt capped at maxBackoff, randomized to [d/2, d).
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	if p.base <= 0 {
+		return 0
+	}
+	// doubling stops at the cap so large attempts can't overflow
+	d := p.base
+	for i := 0; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		d = maxBackoff
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)))
+}
+
+// shouldRetry tells whether the failure is transient and worth another attempt.
+func shouldRetry(ctx context.Context, resp *ClientResponse) bool {
+	if resp.Error == nil || ctx.Err() != nil {
+		return false
+	}
+	// no status code means the request never got a response (network error, timeout)
+	if resp.StatusCode == 0 {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRetry(t *testing.T) {
+	a := New("https://api.warren.io", "secret", WithRetry(3, time.Second))
+	assert.Equal(t, 3, a.retry.max)
+	assert.Equal(t, time.Second, a.retry.base)
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := retryPolicy{max: 3, base: 100 * time.Millisecond}
+	for attempt := 0; attempt < 3; attempt++ {
+		d := p.backoff(attempt)
+		full := p.base << attempt
+		assert.GreaterOrEqual(t, d, full/2)
+		assert.Less(t, d, full)
+	}
+	assert.Equal(t, time.Duration(0), retryPolicy{}.backoff(0))
+
+	// large attempts don't overflow
+	for _, attempt := range []int{20, 63, 64, 1000} {
+		d := p.backoff(attempt)
+		assert.GreaterOrEqual(t, d, maxBackoff/2)
+		assert.Less(t, d, maxBackoff)
+	}
+}
+
+func TestFormRequest_RetryTransient(t *testing.T) {
+	calls := 0
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("OK"))
+	})
+	defer s.Close()
+	WithRetry(3, time.Millisecond)(c)
+
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "POST", Path: "/test"})
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, []byte("OK"), resp.Body)
+	assert.Equal(t, 3, calls)
+}
+
+func TestFormRequest_RetryExhausted(t *testing.T) {
+	calls := 0
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer s.Close()
+	WithRetry(2, time.Millisecond)(c)
+
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.Error(t, resp.Error)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestFormRequest_NoRetryOnClientError(t *testing.T) {
+	calls := 0
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer s.Close()
+	WithRetry(3, time.Millisecond)(c)
+
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.Error(t, resp.Error)
+	assert.Equal(t, 1, calls)
+}
+
+func TestFormRequest_RetryContextCanceled(t *testing.T) {
+	calls := 0
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	defer s.Close()
+	WithRetry(5, time.Hour)(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	resp := c.FormRequest(ctx, RequestConfig{Method: "GET", Path: "/test"})
+	assert.ErrorIs(t, resp.Error, context.DeadlineExceeded)
+	assert.Equal(t, 1, calls)
+}
+
+func TestFormRequest_RetrySameIdempotencyKey(t *testing.T) {
+	var keys []string
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("OK"))
+	})
+	defer s.Close()
+	WithRetry(3, time.Millisecond)(c)
+
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "POST", Path: "/test"})
+	assert.NoError(t, resp.Error)
+	assert.Len(t, keys, 2)
+	assert.NotEmpty(t, keys[0])
+	assert.Equal(t, keys[0], keys[1])
+}
+
+func TestFormRequest_RetryAfter(t *testing.T) {
+	var times []time.Time
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		times = append(times, time.Now())
+		if len(times) < 2 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte("OK"))
+	})
+	defer s.Close()
+	// backoff alone would retry almost immediately
+	WithRetry(3, time.Millisecond)(c)
+
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.NoError(t, resp.Error)
+	assert.Len(t, times, 2)
+	assert.GreaterOrEqual(t, times[1].Sub(times[0]), time.Second)
+}
+
+func TestFormRequest_RetryAfterBeyondDeadline(t *testing.T) {
+	calls := 0
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer s.Close()
+	WithRetry(3, time.Millisecond)(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	start := time.Now()
+	resp := c.FormRequest(ctx, RequestConfig{Method: "GET", Path: "/test"})
+
+	var throttled *ThrottledError
+	assert.ErrorAs(t, resp.Error, &throttled)
+	assert.Equal(t, time.Minute, throttled.RetryAfter)
+	assert.Equal(t, 1, calls)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTimeout(t *testing.T) {
+	a := New("https://api.warren.io", "secret", WithTimeout(time.Minute))
+	assert.Equal(t, time.Minute, a.timeout)
+}
+
+func TestRequestConfig_timeout(t *testing.T) {
+	assert.Equal(t, time.Minute, RequestConfig{}.timeout(time.Minute))
+	assert.Equal(t, time.Second, RequestConfig{Timeout: time.Second}.timeout(time.Minute))
+}
+
+func TestFormRequest_Timeout(t *testing.T) {
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Write([]byte("OK"))
+	})
+	defer s.Close()
+	WithTimeout(10 * time.Millisecond)(c)
+
+	// default timeout exceeded
+	resp := c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.ErrorIs(t, resp.Error, context.DeadlineExceeded)
+
+	// longer per-request timeout
+	resp = c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test", Timeout: time.Second})
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, []byte("OK"), resp.Body)
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Defaults of `PoolConfig` zero fields
+const (
+	DefaultMaxIdleConns    = 100
+	DefaultMaxConnsPerHost = 100
+	DefaultIdleConnTimeout = 90 * time.Second
+)
+
+// PoolConfig tunes HTTP connection pool, zero fields take defaults above.
+// All calls go to the API host so MaxIdleConns is applied per host too,
+// `http.DefaultTransport` keeps only 2 idle connections per host which causes connection churn under load.
+type PoolConfig struct {
+	MaxIdleConns    int
+	MaxConnsPerHost int
+	IdleConnTimeout time.Duration
+}
+
+// WithConnectionPool tunes connection pool for high-throughput clients, e.g. controllers issuing thousands of calls per minute.
+// It has no effect when transport set by `WithTransport()` is not *http.Transport.
+func WithConnectionPool(cfg PoolConfig) Option {
+	if cfg.MaxIdleConns <= 0 {
+		cfg.MaxIdleConns = DefaultMaxIdleConns
+	}
+	if cfg.MaxConnsPerHost <= 0 {
+		cfg.MaxConnsPerHost = DefaultMaxConnsPerHost
+	}
+	if cfg.IdleConnTimeout <= 0 {
+		cfg.IdleConnTimeout = DefaultIdleConnTimeout
+	}
+	return func(a *API) {
+		configureTransport(a, func(t *http.Transport) {
+			t.MaxIdleConns = cfg.MaxIdleConns
+			t.MaxIdleConnsPerHost = cfg.MaxIdleConns
+			t.MaxConnsPerHost = cfg.MaxConnsPerHost
+			t.IdleConnTimeout = cfg.IdleConnTimeout
+		})
+	}
+}
+
+// WithTransport sets transport of the HTTP client, without having to build the client.
+// The HTTP client is copied so `http.DefaultClient` or client set by `WithHTTPClient()` is never modified.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(a *API) {
+		c := copyHTTPClient(a.HTTPClient)
+		c.Transport = rt
+		a.HTTPClient = c
+	}
+}
+
+// WithProxy sends requests through proxy at u, e.g. corporate proxy. Nil u disables proxy,
+// by default proxy is taken from HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+// It has no effect when transport set by `WithTransport()` is not *http.Transport.
+func WithProxy(u *url.URL) Option {
+	return func(a *API) {
+		configureTransport(a, func(t *http.Transport) {
+			if u == nil {
+				t.Proxy = nil
+				return
+			}
+			t.Proxy = http.ProxyURL(u)
+		})
+	}
+}
+
+// WithTLSConfig sets TLS config of the transport, e.g. to trust custom CA bundle through RootCAs.
+// It has no effect when transport set by `WithTransport()` is not *http.Transport.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(a *API) {
+		configureTransport(a, func(t *http.Transport) {
+			t.TLSClientConfig = cfg
+		})
+	}
+}
+
+// configureTransport applies fn to a copy of the current *http.Transport (`http.DefaultTransport` when not set),
+// and sets it to a copy of the HTTP client so neither of the originals is modified.
+func configureTransport(a *API, fn func(*http.Transport)) {
+	c := copyHTTPClient(a.HTTPClient)
+	var t *http.Transport
+	switch rt := c.Transport.(type) {
+	case nil:
+		t = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		t = rt.Clone()
+	default:
+		return
+	}
+	fn(t)
+	c.Transport = t
+	a.HTTPClient = c
+}
+
+// copyHTTPClient returns shallow copy of c, or an empty client when c is nil.
+func copyHTTPClient(c *http.Client) *http.Client {
+	if c == nil {
+		return &http.Client{}
+	}
+	cp := *c
+	return &cp
+}
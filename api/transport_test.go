@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTransport(t *testing.T) {
+	called := false
+	rt := RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {})
+	defer s.Close()
+
+	orig := a.HTTPClient
+	a = a.Clone(WithTransport(roundTripper(rt)))
+	assert.NoError(t, a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"}).Error)
+	assert.True(t, called)
+	assert.NotSame(t, orig, a.HTTPClient)
+	assert.IsType(t, &http.Transport{}, orig.Transport)
+}
+
+func TestWithProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// proxy receives absolute URL of the target
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+	u, _ := url.Parse(proxy.URL)
+
+	a := New("http://api.example.com", "secret", WithProxy(u))
+	assert.NoError(t, a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/v1/disks"}).Error)
+	assert.Equal(t, "http://api.example.com/v1/disks", proxied)
+
+	// default client is left untouched
+	assert.Nil(t, http.DefaultClient.Transport)
+}
+
+func TestWithTLSConfig(t *testing.T) {
+	s := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	s.Config.ErrorLog = log.New(io.Discard, "", 0)
+	s.StartTLS()
+	defer s.Close()
+
+	a := New(s.URL, "secret")
+	assert.Error(t, a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"}).Error)
+
+	// trust the test server certificate
+	cfg := s.Client().Transport.(*http.Transport).TLSClientConfig
+	a = a.Clone(WithTLSConfig(&tls.Config{RootCAs: cfg.RootCAs}))
+	assert.NoError(t, a.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/"}).Error)
+}
+
+func TestWithTLSConfig_CustomTransport(t *testing.T) {
+	rt := roundTripper(RoundTripFunc(http.DefaultTransport.RoundTrip))
+	a := New("http://api.example.com", "secret", WithTransport(rt), WithTLSConfig(&tls.Config{}))
+	assert.IsType(t, rt, a.HTTPClient.Transport)
+}
+
+// roundTripper adapts RoundTripFunc to http.RoundTripper
+type roundTripper RoundTripFunc
+
+func (f roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWithConnectionPool(t *testing.T) {
+	a := New("http://api.example.com", "secret", WithConnectionPool(PoolConfig{MaxConnsPerHost: 10}))
+	tr := a.HTTPClient.Transport.(*http.Transport)
+	assert.Equal(t, DefaultMaxIdleConns, tr.MaxIdleConns)
+	assert.Equal(t, DefaultMaxIdleConns, tr.MaxIdleConnsPerHost)
+	assert.Equal(t, 10, tr.MaxConnsPerHost)
+	assert.Equal(t, DefaultIdleConnTimeout, tr.IdleConnTimeout)
+
+	// combines with other transport options
+	a = a.Clone(WithTLSConfig(&tls.Config{ServerName: "api"}))
+	tr = a.HTTPClient.Transport.(*http.Transport)
+	assert.Equal(t, 10, tr.MaxConnsPerHost)
+	assert.Equal(t, "api", tr.TLSClientConfig.ServerName)
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"os"
+)
+
+// FileField is a file sent within multipart form under Field name.
+// Reader is consumed once, so requests with files are never retried.
+type FileField struct {
+	Field    string
+	FileName string
+	Reader   io.Reader
+}
+
+// size returns number of bytes the file has, -1 when unknown.
+func (f FileField) size() int64 {
+	switch r := f.Reader.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len())
+	case *os.File:
+		if fi, err := r.Stat(); err == nil && fi.Mode().IsRegular() {
+			return fi.Size()
+		}
+	}
+	return -1
+}
+
+// UploadRequest make a call with multipart-encoded payload containing cfg.Files and cfg.Data,
+// the body is streamed so files are never fully loaded into memory.
+// Progress, when not nil, is called as file content is sent, total is -1 when any file size is unknown.
+func (a *API) UploadRequest(ctx context.Context, cfg RequestConfig, progress ProgressFunc) *ClientResponse {
+	if len(cfg.Files) == 0 {
+		return &ClientResponse{Error: errors.New("no files to upload")}
+	}
+	cfg.progress = progress
+	return a.request(ctx, cfg, "multipart/form-data")
+}
+
+// multipartBody returns reader streaming Data and Files as multipart form, along with its content type.
+func (r RequestConfig) multipartBody() (io.ReadCloser, string, error) {
+	if r.JSON != nil {
+		return nil, "", errors.New("files and json can not be set at the same time")
+	}
+	for _, f := range r.Files {
+		if f.Reader == nil {
+			return nil, "", errors.New("file reader can not be nil")
+		}
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(r.writeMultipart(mw))
+	}()
+	return pr, mw.FormDataContentType(), nil
+}
+
+func (r RequestConfig) writeMultipart(mw *multipart.Writer) error {
+	for k, vs := range r.Data {
+		for _, v := range vs {
+			if err := mw.WriteField(k, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	var dst io.Writer
+	var pw *progressWriter
+	if r.progress != nil {
+		total := int64(0)
+		for _, f := range r.Files {
+			size := f.size()
+			if size < 0 {
+				total = -1
+				break
+			}
+			total += size
+		}
+		pw = &progressWriter{total: total, fn: r.progress}
+	}
+	for _, f := range r.Files {
+		part, err := mw.CreateFormFile(f.Field, f.FileName)
+		if err != nil {
+			return err
+		}
+		dst = part
+		if pw != nil {
+			pw.w = part
+			dst = pw
+		}
+		if _, err := io.Copy(dst, f.Reader); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
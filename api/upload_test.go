@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadRequest(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/images", r.RequestURI)
+		assert.Equal(t, "secret", r.Header.Get("apikey"))
+
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "my-image", r.FormValue("name"))
+		f, h, err := r.FormFile("file")
+		assert.NoError(t, err)
+		assert.Equal(t, "disk.img", h.Filename)
+		b, _ := io.ReadAll(f)
+		assert.Equal(t, "image-content", string(b))
+		w.Write([]byte(`{"id":"1"}`))
+	})
+	defer s.Close()
+
+	var written, total int64
+	cfg := RequestConfig{
+		Method: "POST",
+		Path:   "/v1/images",
+		Data:   url.Values{"name": []string{"my-image"}},
+		Files:  []FileField{{Field: "file", FileName: "disk.img", Reader: strings.NewReader("image-content")}},
+	}
+	resp := a.UploadRequest(context.Background(), cfg, func(w, t int64) {
+		written, total = w, t
+	})
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, `{"id":"1"}`, string(resp.Body))
+	assert.Equal(t, int64(13), written)
+	assert.Equal(t, int64(13), total)
+}
+
+func TestUploadRequest_UnknownSize(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {})
+	defer s.Close()
+
+	var total int64
+	cfg := RequestConfig{
+		Method: "POST",
+		Path:   "/",
+		Files:  []FileField{{Field: "file", FileName: "a", Reader: io.LimitReader(strings.NewReader("abc"), 3)}},
+	}
+	resp := a.UploadRequest(context.Background(), cfg, func(_, t int64) {
+		total = t
+	})
+	assert.NoError(t, resp.Error)
+	assert.Equal(t, int64(-1), total)
+}
+
+func TestUploadRequest_NotRetried(t *testing.T) {
+	calls := 0
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer s.Close()
+	WithRetry(3, 0)(a)
+
+	cfg := RequestConfig{
+		Method: "POST",
+		Path:   "/",
+		Files:  []FileField{{Field: "file", FileName: "a", Reader: strings.NewReader("abc")}},
+	}
+	resp := a.UploadRequest(context.Background(), cfg, nil)
+	assert.ErrorIs(t, resp.Error, ErrServer)
+	assert.Equal(t, 1, calls)
+}
+
+func TestUploadRequest_Invalid(t *testing.T) {
+	a, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	})
+	defer s.Close()
+
+	resp := a.UploadRequest(context.Background(), RequestConfig{Method: "POST", Path: "/"}, nil)
+	assert.EqualError(t, resp.Error, "no files to upload")
+
+	cfg := RequestConfig{
+		Method: "POST",
+		Path:   "/",
+		JSON:   map[string]string{},
+		Files:  []FileField{{Field: "file", FileName: "a", Reader: strings.NewReader("abc")}},
+	}
+	resp = a.UploadRequest(context.Background(), cfg, nil)
+	assert.EqualError(t, resp.Error, "files and json can not be set at the same time")
+}
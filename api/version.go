@@ -0,0 +1,27 @@
+package api
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Version of this library, sent as part of User-Agent header
+const Version = "v0.1.0"
+
+// defaultUserAgent identifies the library, Go version and platform, e.g. `warren-go/v0.1.0 (go1.22.0; linux/amd64)`.
+var defaultUserAgent = fmt.Sprintf("warren-go/%s (%s; %s/%s)", Version, runtime.Version(), runtime.GOOS, runtime.GOARCH)
+
+// WithUserAgentSuffix appends suffix (e.g. `myapp/1.2.3`) to User-Agent header so requests can be traced back to application.
+func WithUserAgentSuffix(suffix string) Option {
+	return func(a *API) {
+		a.userAgentSuffix = suffix
+	}
+}
+
+// userAgent returns User-Agent header value, with suffix when set.
+func (a *API) userAgent() string {
+	if a.userAgentSuffix == "" {
+		return defaultUserAgent
+	}
+	return defaultUserAgent + " " + a.userAgentSuffix
+}
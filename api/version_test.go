@@ -0,0 +1,25 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserAgent(t *testing.T) {
+	ua := ""
+	c, s := MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		ua = r.Header.Get("User-Agent")
+	})
+	defer s.Close()
+
+	c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.Equal(t, "warren-go/"+Version+" ("+runtime.Version()+"; "+runtime.GOOS+"/"+runtime.GOARCH+")", ua)
+
+	WithUserAgentSuffix("myapp/1.2.3")(c)
+	c.FormRequest(context.Background(), RequestConfig{Method: "GET", Path: "/test"})
+	assert.Equal(t, defaultUserAgent+" myapp/1.2.3", ua)
+}
@@ -0,0 +1,127 @@
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+// ErrInsufficientBalance is returned by `CheckBalance()` when credit is below the threshold
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+func NewClient(client *api.API) *Client {
+	return &Client{
+		API: client,
+	}
+}
+
+// ListBillingAccounts https://api.warren.io/#list-billing-accounts
+func (c *Client) ListBillingAccounts(ctx context.Context) (*[]BillingAccount, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/payment/billing_account/list",
+	}
+	var accounts []BillingAccount
+	if err := c.API.FormRequest(ctx, rc).Into(&accounts); err != nil {
+		return nil, err
+	}
+	return &accounts, nil
+}
+
+// ListBillingAccountsIterator returns iterator over `ListBillingAccounts()` results, fetching limit items per page.
+func (c *Client) ListBillingAccountsIterator(limit int) *api.Iterator[BillingAccount] {
+	return api.NewIterator(func(ctx context.Context, page, limit int) ([]BillingAccount, error) {
+		rc := api.RequestConfig{
+			Method: "GET",
+			Path:   "/v1/payment/billing_account/list",
+			Page:   page,
+			Limit:  limit,
+		}
+		var accounts []BillingAccount
+		if err := c.API.FormRequest(ctx, rc).Into(&accounts); err != nil {
+			return nil, err
+		}
+		return accounts, nil
+	}, limit)
+}
+
+// GetBillingAccount https://api.warren.io/#get-billing-account
+func (c *Client) GetBillingAccount(ctx context.Context, id int) (*BillingAccount, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/payment/billing_account/%d", id),
+	}
+	var account BillingAccount
+	if err := c.API.FormRequest(ctx, rc).Into(&account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// GetDefaultBillingAccount returns billing account that marked as default.
+func (c *Client) GetDefaultBillingAccount(ctx context.Context) (*BillingAccount, error) {
+	accounts, err := c.ListBillingAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range *accounts {
+		if a.IsDefault {
+			return &a, nil
+		}
+	}
+	return nil, errors.New("no default billing account found")
+}
+
+// GetLimits https://api.warren.io/#get-billing-account-limits
+func (c *Client) GetLimits(ctx context.Context, id int) (*Limits, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/payment/billing_account/%d/limits", id),
+	}
+	var limits Limits
+	if err := c.API.FormRequest(ctx, rc).Into(&limits); err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
+
+// GetOngoingUsage https://api.warren.io/#get-ongoing-usage
+func (c *Client) GetOngoingUsage(ctx context.Context, id int) (*Usage, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/payment/billing_account/%d/ongoing_usage", id),
+	}
+	var usage Usage
+	if err := c.API.FormRequest(ctx, rc).Into(&usage); err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// GetBalance https://api.warren.io/#get-balance
+func (c *Client) GetBalance(ctx context.Context, id int) (*Balance, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/payment/billing_account/%d/balance", id),
+	}
+	var balance Balance
+	if err := c.API.FormRequest(ctx, rc).Into(&balance); err != nil {
+		return nil, err
+	}
+	return &balance, nil
+}
+
+// CheckBalance returns the balance along with error wrapping `ErrInsufficientBalance` when its amount is below min,
+// e.g. to stop before large provisioning runs.
+func (c *Client) CheckBalance(ctx context.Context, id int, min float64) (*Balance, error) {
+	balance, err := c.GetBalance(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if balance.Amount < min {
+		return balance, fmt.Errorf("%w: %.2f %s left, %.2f required", ErrInsufficientBalance, balance.Amount, balance.Currency, min)
+	}
+	return balance, nil
+}
@@ -0,0 +1,116 @@
+package billing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListBillingAccounts(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/billing_account/list", r.RequestURI)
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	b.ListBillingAccounts(context.Background())
+}
+
+func TestGetBillingAccount(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/billing_account/123", r.RequestURI)
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	b.GetBillingAccount(context.Background(), 123)
+}
+
+func TestGetDefaultBillingAccount(t *testing.T) {
+	body := `[{"id":1,"is_default":false},{"id":2,"is_default":true}]`
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/payment/billing_account/list", r.RequestURI)
+		w.Write([]byte(body))
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	account, err := b.GetDefaultBillingAccount(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, account.ID)
+
+	// no default
+	body = `[{"id":1,"is_default":false}]`
+	_, err = b.GetDefaultBillingAccount(context.Background())
+	assert.Error(t, err)
+}
+
+func TestGetLimits(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/billing_account/123/limits", r.RequestURI)
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	b.GetLimits(context.Background(), 123)
+}
+
+func TestGetOngoingUsage(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/billing_account/123/ongoing_usage", r.RequestURI)
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	b.GetOngoingUsage(context.Background(), 123)
+}
+
+func TestGetBalance(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/billing_account/123/balance", r.RequestURI)
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	b.GetBalance(context.Background(), 123)
+}
+
+func TestCheckBalance(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"billing_account_id":123,"amount":50000,"currency":"IDR"}`))
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	balance, err := b.CheckBalance(context.Background(), 123, 10000)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(50000), balance.Amount)
+
+	balance, err = b.CheckBalance(context.Background(), 123, 100000)
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+	assert.EqualError(t, err, "insufficient balance: 50000.00 IDR left, 100000.00 required")
+	assert.NotNil(t, balance)
+}
+
+func TestListBillingAccountsIterator(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/billing_account/list?limit=2&page=1", r.RequestURI)
+		w.Write([]byte("[{}]"))
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	it := b.ListBillingAccountsIterator(2)
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
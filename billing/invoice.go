@@ -0,0 +1,66 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+// Invoice statuses
+const (
+	InvoiceStatusUnpaid = "unpaid"
+	InvoiceStatusPaid   = "paid"
+)
+
+// Invoice is a monthly bill of a billing account
+type Invoice struct {
+	ID               int     `json:"id"`
+	Number           string  `json:"number"`
+	BillingAccountID int     `json:"billing_account_id"`
+	Status           string  `json:"status"`
+	Amount           float64 `json:"amount"`
+	Currency         string  `json:"currency"`
+	PeriodStart      string  `json:"period_start"`
+	PeriodEnd        string  `json:"period_end"`
+	DueDate          string  `json:"due_date"`
+	CreatedAt        string  `json:"created_at"`
+}
+
+// ListInvoices https://api.warren.io/#list-invoices
+func (c *Client) ListInvoices(ctx context.Context, id int) (*[]Invoice, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/payment/billing_account/%d/invoices", id),
+	}
+	var invoices []Invoice
+	if err := c.API.FormRequest(ctx, rc).Into(&invoices); err != nil {
+		return nil, err
+	}
+	return &invoices, nil
+}
+
+// GetInvoice https://api.warren.io/#get-invoice
+func (c *Client) GetInvoice(ctx context.Context, invoiceID int) (*Invoice, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/payment/invoice/%d", invoiceID),
+	}
+	var invoice Invoice
+	if err := c.API.FormRequest(ctx, rc).Into(&invoice); err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// DownloadInvoicePDF https://api.warren.io/#download-invoice
+// PDF document is streamed into w.
+func (c *Client) DownloadInvoicePDF(ctx context.Context, invoiceID int, w io.Writer) error {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/payment/invoice/%d/pdf", invoiceID),
+	}
+	_, err := c.API.Download(ctx, rc, w, nil)
+	return err
+}
@@ -0,0 +1,48 @@
+package billing
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListInvoices(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/billing_account/123/invoices", r.RequestURI)
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	b.ListInvoices(context.Background(), 123)
+}
+
+func TestGetInvoice(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/invoice/456", r.RequestURI)
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	b.GetInvoice(context.Background(), 456)
+}
+
+func TestDownloadInvoicePDF(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/invoice/456/pdf", r.RequestURI)
+		w.Write([]byte("%PDF-1.4"))
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	var buf bytes.Buffer
+	err := b.DownloadInvoicePDF(context.Background(), 456, &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4", buf.String())
+}
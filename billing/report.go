@@ -0,0 +1,84 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+// reportDateLayout is the date format accepted by usage report endpoint
+const reportDateLayout = "2006-01-02"
+
+// Resource types of usage line items
+const (
+	ResourceTypeVM         = "vm"
+	ResourceTypeDisk       = "disk"
+	ResourceTypeFloatingIP = "floating_ip"
+)
+
+// LineItem is the cost of a single resource within report period, Quantity is measured in Unit (e.g. hours).
+type LineItem struct {
+	ResourceType string    `json:"resource_type"`
+	ResourceUUID uuid.UUID `json:"resource_uuid"`
+	Description  string    `json:"description"`
+	Quantity     float64   `json:"quantity"`
+	Unit         string    `json:"unit"`
+	Price        float64   `json:"price"`
+	Amount       float64   `json:"amount"`
+	PeriodStart  string    `json:"period_start"`
+	PeriodEnd    string    `json:"period_end"`
+}
+
+// UsageReport is cost breakdown of a billing account over a time range
+type UsageReport struct {
+	BillingAccountID int        `json:"billing_account_id"`
+	Currency         string     `json:"currency"`
+	PeriodStart      string     `json:"period_start"`
+	PeriodEnd        string     `json:"period_end"`
+	Total            float64    `json:"total"`
+	Items            []LineItem `json:"items"`
+}
+
+// TotalsByResourceType sums Amount of line items grouped by their resource type.
+func (r UsageReport) TotalsByResourceType() map[string]float64 {
+	totals := map[string]float64{}
+	for _, i := range r.Items {
+		totals[i.ResourceType] += i.Amount
+	}
+	return totals
+}
+
+// TotalsByResource sums Amount of line items grouped by their resource UUID.
+func (r UsageReport) TotalsByResource() map[uuid.UUID]float64 {
+	totals := map[uuid.UUID]float64{}
+	for _, i := range r.Items {
+		totals[i.ResourceUUID] += i.Amount
+	}
+	return totals
+}
+
+// GetUsageReport https://api.warren.io/#get-usage-report
+// Only date part of from and to are used, both are inclusive.
+func (c *Client) GetUsageReport(ctx context.Context, id int, from, to time.Time) (*UsageReport, error) {
+	if to.Before(from) {
+		return nil, fmt.Errorf("to with value of %v is invalid, must not be before from", to.Format(reportDateLayout))
+	}
+
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/payment/billing_account/%d/usage", id),
+		Query: url.Values{
+			"start_date": {from.Format(reportDateLayout)},
+			"end_date":   {to.Format(reportDateLayout)},
+		},
+	}
+	var report UsageReport
+	if err := c.API.FormRequest(ctx, rc).Into(&report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
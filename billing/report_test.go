@@ -0,0 +1,40 @@
+package billing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUsageReport(t *testing.T) {
+	body := `{"billing_account_id":123,"currency":"IDR","total":150,"items":[
+		{"resource_type":"vm","resource_uuid":"4e5eadd3-8b11-4c34-812a-2cf97120b628","amount":100},
+		{"resource_type":"disk","resource_uuid":"1a2d6a39-5bd4-4cb0-9bd7-0b1a4b6d0c7e","amount":30},
+		{"resource_type":"disk","resource_uuid":"1a2d6a39-5bd4-4cb0-9bd7-0b1a4b6d0c7e","amount":20}
+	]}`
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/payment/billing_account/123/usage?end_date=2024-01-31&start_date=2024-01-01", r.RequestURI)
+		w.Write([]byte(body))
+	})
+	defer s.Close()
+
+	b := Client{API: a}
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	// invalid range
+	_, err := b.GetUsageReport(context.Background(), 123, to, from)
+	assert.Error(t, err)
+
+	report, err := b.GetUsageReport(context.Background(), 123, from, to)
+	assert.NoError(t, err)
+	assert.Len(t, report.Items, 3)
+	assert.Equal(t, map[string]float64{ResourceTypeVM: 100, ResourceTypeDisk: 50}, report.TotalsByResourceType())
+	assert.Equal(t, float64(50), report.TotalsByResource()[uuid.MustParse("1a2d6a39-5bd4-4cb0-9bd7-0b1a4b6d0c7e")])
+}
@@ -0,0 +1,45 @@
+package billing
+
+import "github.com/ekaputra07/warren-go/api"
+
+type Client struct {
+	API *api.API
+}
+
+// BillingAccount is used to pay for resources, its ID is the `billing_account_id` required when creating resources.
+type BillingAccount struct {
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	Email     string `json:"email"`
+	IsActive  bool   `json:"is_active"`
+	IsDefault bool   `json:"is_default"`
+	Suspended bool   `json:"suspended"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// Limits holds maximum amount of resources allowed for a billing account, RAM is in MB and Storage in GB.
+type Limits struct {
+	MaxVMs         int `json:"max_vms"`
+	MaxVCPU        int `json:"max_vcpu"`
+	MaxRAM         int `json:"max_ram"`
+	MaxStorage     int `json:"max_storage"`
+	MaxFloatingIPs int `json:"max_floating_ips"`
+	MaxBuckets     int `json:"max_buckets"`
+}
+
+// Usage is the running cost of current billing period
+type Usage struct {
+	BillingAccountID int     `json:"billing_account_id"`
+	Amount           float64 `json:"amount"`
+	Currency         string  `json:"currency"`
+	PeriodStart      string  `json:"period_start"`
+	PeriodEnd        string  `json:"period_end"`
+}
+
+// Balance is the prepaid credit left on a billing account, Amount already accounts for ongoing usage.
+type Balance struct {
+	BillingAccountID int     `json:"billing_account_id"`
+	Amount           float64 `json:"amount"`
+	Currency         string  `json:"currency"`
+}
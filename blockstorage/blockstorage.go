@@ -2,14 +2,15 @@ package blockstorage
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 
 	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/bulk"
+	"github.com/ekaputra07/warren-go/query"
+	"github.com/ekaputra07/warren-go/waiter"
 	"github.com/google/uuid"
-	"github.com/gorilla/schema"
 )
 
 func NewClient(client *api.API) *Client {
@@ -19,28 +20,62 @@ func NewClient(client *api.API) *Client {
 }
 
 // ListDisks https://api.warren.io/#list-disks
-func (c *Client) LisDisks(ctx context.Context) (*[]Disk, error) {
+// Filters of opts are sent in the query string and applied again on the client side, so results are the same
+// whether the API supports them or not; sorting is done on the client side. Zero value returns all disks in API order.
+func (c *Client) ListDisks(ctx context.Context, opts ListDisksOptions) (*[]Disk, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	q, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
 	rc := api.RequestConfig{
 		Method: "GET",
 		Path:   "/v1/storage/disks",
+		Query:  q,
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return nil, resp.Error
-	}
-	var disks []Disk
-	if err := json.Unmarshal(resp.Body, &disks); err != nil {
+	disks, err := api.Call[[]Disk](ctx, c.API, rc)
+	if err != nil {
 		return nil, err
 	}
-	return &disks, nil
+	filtered := opts.apply(*disks)
+	return &filtered, nil
+}
+
+// LisDisks returns all disks.
+//
+// Deprecated: use `ListDisks()` instead.
+func (c *Client) LisDisks(ctx context.Context) (*[]Disk, error) {
+	return c.ListDisks(ctx, ListDisksOptions{})
+}
+
+// ListDisksIterator returns iterator over `ListDisks()` results, fetching limit items per page.
+func (c *Client) ListDisksIterator(limit int) *api.Iterator[Disk] {
+	return api.NewIterator(func(ctx context.Context, page, limit int) ([]Disk, error) {
+		rc := api.RequestConfig{
+			Method: "GET",
+			Path:   "/v1/storage/disks",
+			Page:   page,
+			Limit:  limit,
+		}
+		var disks []Disk
+		if err := c.API.FormRequest(ctx, rc).Into(&disks); err != nil {
+			return nil, err
+		}
+		return disks, nil
+	}, limit)
 }
 
 // CreateDisk https://api.warren.io/#create-disk
-func (c *Client) CreateDisk(ctx context.Context, disk *Disk) error {
-	enc := schema.NewEncoder()
-	d := url.Values{}
-	if err := enc.Encode(disk, d); err != nil {
-		return err
+func (c *Client) CreateDisk(ctx context.Context, cfg CreateDiskConfig) (*Disk, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	d, err := api.EncodeForm(cfg)
+	if err != nil {
+		return nil, err
 	}
 
 	rc := api.RequestConfig{
@@ -48,11 +83,28 @@ func (c *Client) CreateDisk(ctx context.Context, disk *Disk) error {
 		Path:   "/v1/storage/disks",
 		Data:   d,
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return resp.Error
+	return api.Call[Disk](ctx, c.API, rc)
+}
+
+// CloneDisk creates a new disk as a copy of source disk, cfg.SourceImageType and cfg.SourceImage are set from source.
+// SizeGB defaults to source disk size and can't be smaller than it.
+func (c *Client) CloneDisk(ctx context.Context, sourceDiskID uuid.UUID, cfg CreateDiskConfig) (*Disk, error) {
+	source, err := c.GetDisk(ctx, sourceDiskID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.SizeGB == 0 {
+		cfg.SizeGB = source.SizeGB
 	}
-	return json.Unmarshal(resp.Body, disk)
+	if cfg.SizeGB < source.SizeGB {
+		return nil, fmt.Errorf("SizeGB with value of %v is invalid, must be at least %d", cfg.SizeGB, source.SizeGB)
+	}
+	if cfg.BillingAccountID == 0 {
+		cfg.BillingAccountID = source.BillingAccountID
+	}
+	cfg.SourceImageType = ImageTypeDisk
+	cfg.SourceImage = sourceDiskID.String()
+	return c.CreateDisk(ctx, cfg)
 }
 
 // GetDisk https://api.warren.io/#get-disk
@@ -61,15 +113,7 @@ func (c *Client) GetDisk(ctx context.Context, diskID uuid.UUID) (*Disk, error) {
 		Method: "GET",
 		Path:   fmt.Sprintf("/v1/storage/disks/%s", diskID),
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return nil, resp.Error
-	}
-	var disk Disk
-	if err := json.Unmarshal(resp.Body, &disk); err != nil {
-		return nil, err
-	}
-	return &disk, nil
+	return api.Call[Disk](ctx, c.API, rc)
 }
 
 // DeleteDisk https://api.warren.io/#delete-disk
@@ -81,7 +125,13 @@ func (c *Client) DeleteDisk(ctx context.Context, diskID uuid.UUID) error {
 	return c.API.FormRequest(ctx, rc).Error
 }
 
+// DeleteDisks deletes disks concurrently, failed ones are reported in *bulk.Error[uuid.UUID].
+func (c *Client) DeleteDisks(ctx context.Context, diskIDs []uuid.UUID, opts bulk.Options) error {
+	return bulk.Run(ctx, diskIDs, opts, c.DeleteDisk)
+}
+
 // AttachDiskToVM https://api.warren.io/#attach-disk
+// It returns as soon as the request is accepted, use `vm.Client.AttachDiskAndWait()` to wait for the attachment.
 func (c *Client) AttachDiskToVM(ctx context.Context, diskID, vmID uuid.UUID) error {
 	d := url.Values{
 		"uuid":         []string{vmID.String()},
@@ -96,6 +146,7 @@ func (c *Client) AttachDiskToVM(ctx context.Context, diskID, vmID uuid.UUID) err
 }
 
 // DetachDiskFromVM https://api.warren.io/#detach-disk
+// It returns as soon as the request is accepted, use `vm.Client.DetachDiskAndWait()` to wait for the detachment.
 func (c *Client) DetachDiskFromVM(ctx context.Context, diskID, vmID uuid.UUID) error {
 	d := url.Values{
 		"uuid":         []string{vmID.String()},
@@ -118,3 +169,54 @@ func (c *Client) UpdateDiskBillingAccount(ctx context.Context, diskID uuid.UUID,
 	}
 	return c.API.FormRequest(ctx, rc).Error
 }
+
+// UpdateDisk https://api.warren.io/#modify-disk-info
+func (c *Client) UpdateDisk(ctx context.Context, diskID uuid.UUID, cfg UpdateDiskConfig) (*Disk, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	d, err := api.EncodeForm(cfg)
+	if err != nil {
+		return nil, err
+	}
+	rc := api.RequestConfig{
+		Method: "PATCH",
+		Path:   fmt.Sprintf("/v1/storage/disks/%s", diskID),
+		Data:   d,
+	}
+	return api.Call[Disk](ctx, c.API, rc)
+}
+
+// ResizeDisk https://api.warren.io/#modify-disk-info
+//
+// Disks can only grow, newSizeGB must be larger than current disk size.
+func (c *Client) ResizeDisk(ctx context.Context, diskID uuid.UUID, newSizeGB int) (*Disk, error) {
+	disk, err := c.GetDisk(ctx, diskID)
+	if err != nil {
+		return nil, err
+	}
+	if newSizeGB <= disk.SizeGB {
+		return nil, fmt.Errorf("SizeGB with value of %v is invalid, must be larger than %d", newSizeGB, disk.SizeGB)
+	}
+
+	rc := api.RequestConfig{
+		Method: "PATCH",
+		Path:   fmt.Sprintf("/v1/storage/disks/%s", diskID),
+		Data:   url.Values{"size_gb": []string{strconv.Itoa(newSizeGB)}},
+	}
+	return api.Call[Disk](ctx, c.API, rc)
+}
+
+// WaitForDiskStatus polls disk until it reaches given status or ctx is done, and returns the latest disk.
+func (c *Client) WaitForDiskStatus(ctx context.Context, diskID uuid.UUID, status string, opts ...waiter.Option) (*Disk, error) {
+	var disk *Disk
+	err := waiter.ForStatus(ctx, func(ctx context.Context) (string, error) {
+		d, err := c.GetDisk(ctx, diskID)
+		if err != nil {
+			return "", err
+		}
+		disk = d
+		return d.Status, nil
+	}, status, opts...)
+	return disk, err
+}
@@ -6,8 +6,12 @@ import (
 	"net/http"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/bulk"
+	"github.com/ekaputra07/warren-go/image"
+	"github.com/ekaputra07/warren-go/waiter"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -23,40 +27,201 @@ func TestListDisks(t *testing.T) {
 	bs.LisDisks(context.Background())
 }
 
+func TestListDisks_Options(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/storage/disks", r.URL.Path)
+		w.Write([]byte(`[
+			{"status":"ready","size_gb":20,"billing_account_id":1,"created_at":"2024-01-02"},
+			{"status":"creating","size_gb":10,"billing_account_id":1,"created_at":"2024-01-03"},
+			{"status":"ready","size_gb":30,"billing_account_id":1,"created_at":"2024-01-01"},
+			{"status":"ready","size_gb":40,"billing_account_id":2,"created_at":"2024-01-04"}
+		]`))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disks, err := bs.ListDisks(context.Background(), ListDisksOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, *disks, 4)
+
+	disks, err = bs.ListDisks(context.Background(), ListDisksOptions{BillingAccountID: 1, Status: "ready", SortBy: SortBySizeGB, Desc: true})
+	assert.NoError(t, err)
+	assert.Len(t, *disks, 2)
+	assert.Equal(t, 30, (*disks)[0].SizeGB)
+	assert.Equal(t, 20, (*disks)[1].SizeGB)
+
+	disks, err = bs.ListDisks(context.Background(), ListDisksOptions{SortBy: SortByCreatedAt})
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-01", (*disks)[0].CreatedAt)
+	assert.Equal(t, "2024-01-04", (*disks)[3].CreatedAt)
+}
+
+func TestListDisks_Attached(t *testing.T) {
+	vmID := uuid.New()
+	var queries []string
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		// filters are ignored by this server
+		w.Write([]byte(fmt.Sprintf(`[
+			{"size_gb":10,"vm_uuid":"%s"},
+			{"size_gb":20,"vm_uuid":null},
+			{"size_gb":30,"vm_uuid":"%s"}
+		]`, vmID, uuid.New())))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disks, err := bs.ListDisks(context.Background(), ListDisksOptions{AttachedVMUUID: vmID})
+	assert.NoError(t, err)
+	assert.Len(t, *disks, 1)
+	assert.Equal(t, 10, (*disks)[0].SizeGB)
+
+	disks, err = bs.ListDisks(context.Background(), ListDisksOptions{Unattached: true})
+	assert.NoError(t, err)
+	assert.Len(t, *disks, 1)
+	assert.Equal(t, 20, (*disks)[0].SizeGB)
+
+	assert.Equal(t, []string{"vm_uuid=" + vmID.String(), "unattached=true"}, queries)
+
+	_, err = bs.ListDisks(context.Background(), ListDisksOptions{AttachedVMUUID: vmID, Unattached: true})
+	assert.EqualError(t, err, fmt.Sprintf("AttachedVMUUID and Unattached with value of %s true is invalid, only one can be set", vmID))
+}
+
 func TestCreateDisk(t *testing.T) {
-	disk := Disk{
+	cfg := CreateDiskConfig{
 		SizeGB:           10,
 		BillingAccountID: 123,
 		SourceImageType:  ImageTypeOSBase,
 		SourceImage:      "ubuntu_20.04",
 	}
+	id := uuid.New()
 	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "POST", r.Method)
 		assert.Equal(t, "/v1/storage/disks", r.RequestURI)
 
 		_ = r.ParseForm()
 
-		assert.Equal(t, strconv.Itoa(disk.SizeGB), r.Form.Get("size_gb"))
-		assert.Equal(t, strconv.Itoa(disk.BillingAccountID), r.Form.Get("billing_account_id"))
+		assert.Equal(t, strconv.Itoa(cfg.SizeGB), r.Form.Get("size_gb"))
+		assert.Equal(t, strconv.Itoa(cfg.BillingAccountID), r.Form.Get("billing_account_id"))
 		assert.Equal(t, string(ImageTypeOSBase), r.Form.Get("source_image_type"))
-		assert.Equal(t, disk.SourceImage, r.Form.Get("source_image"))
+		assert.Equal(t, cfg.SourceImage, r.Form.Get("source_image"))
+
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"creating","size_gb":10,"billing_account_id":123}`, id)))
 	})
 	defer s.Close()
 
 	bs := Client{API: a}
-	bs.CreateDisk(context.Background(), &disk)
+	disk, err := bs.CreateDisk(context.Background(), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, id, disk.UUID)
+	assert.Equal(t, "creating", disk.Status)
+	assert.Equal(t, 10, disk.SizeGB)
+	assert.Equal(t, 123, disk.BillingAccountID)
 }
 
-func TestGetDisk(t *testing.T) {
+func TestCreateDiskConfig_Validate(t *testing.T) {
+	cfg := CreateDiskConfig{SizeGB: 20, SourceImageType: ImageTypeEmpty}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.SizeGB = 0
+	assert.EqualError(t, cfg.Validate(), "SizeGB with value of 0 is invalid, must be larger than 0")
+
+	cfg.SizeGB = 20
+	cfg.SourceImageType = ImageTypeOSBase
+	assert.EqualError(t, cfg.Validate(), `SourceImage with value of "" is invalid, must be set for OS_BASE`)
+
+	cfg.SourceImageType = ""
+	assert.EqualError(t, cfg.Validate(), `SourceImageType with value of "" is invalid, must be one of OS_BASE, DISK, SNAPSHOT, EMPTY`)
+
+	// request is not sent
+	bs := Client{API: api.New("http://127.0.0.1:0", "secret")}
+	_, err := bs.CreateDisk(context.Background(), cfg)
+	assert.Error(t, err)
+}
+
+func TestCreateDiskConfig_ValidateImage(t *testing.T) {
+	images := []image.Image{{ID: "ubuntu_20.04", Type: image.TypeOSBase, MinDiskSizeGB: 20}}
+
+	cfg := CreateDiskConfig{SizeGB: 20, SourceImageType: ImageTypeOSBase, SourceImage: "ubuntu_20.04"}
+	assert.NoError(t, cfg.ValidateImage(images))
+
+	cfg.SizeGB = 10
+	assert.EqualError(t, cfg.ValidateImage(images), "SizeGB with value of 10 is invalid, must be at least 20")
+
+	cfg.SourceImageType = ImageTypeDisk
+	assert.EqualError(t, cfg.ValidateImage(images), "SourceImage with value of ubuntu_20.04 is invalid")
+
+	cfg.SourceImageType = ImageTypeEmpty
+	assert.NoError(t, cfg.ValidateImage(images))
+}
+
+func TestCloneDisk(t *testing.T) {
+	id := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s", id), r.RequestURI)
+			w.Write([]byte(`{"size_gb":20,"billing_account_id":123}`))
+			return
+		}
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/storage/disks", r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, "20", r.Form.Get("size_gb"))
+		assert.Equal(t, "123", r.Form.Get("billing_account_id"))
+		assert.Equal(t, "DISK", r.Form.Get("source_image_type"))
+		assert.Equal(t, id.String(), r.Form.Get("source_image"))
+		w.Write([]byte(`{"size_gb":20}`))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.CloneDisk(context.Background(), id, CreateDiskConfig{})
+	assert.NoError(t, err)
+	assert.Equal(t, 20, disk.SizeGB)
+}
+
+func TestCloneDisk_TooSmall(t *testing.T) {
 	id := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Write([]byte(`{"size_gb":20}`))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.CloneDisk(context.Background(), id, CreateDiskConfig{SizeGB: 10})
+	assert.Nil(t, disk)
+	assert.EqualError(t, err, "SizeGB with value of 10 is invalid, must be at least 20")
+}
+
+func TestGetDisk(t *testing.T) {
+	id, vmID := uuid.New(), uuid.New()
 	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "GET", r.Method)
 		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s", id), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","size_gb":20,"vm_uuid":"%s"}`, id, vmID)))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.GetDisk(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, id, disk.UUID)
+	assert.Equal(t, uuid.NullUUID{UUID: vmID, Valid: true}, disk.AttachedVMUUID)
+}
+
+func TestGetDisk_Unattached(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"size_gb":20,"vm_uuid":null}`))
 	})
 	defer s.Close()
 
 	bs := Client{API: a}
-	bs.GetDisk(context.Background(), id)
+	disk, err := bs.GetDisk(context.Background(), uuid.New())
+	assert.NoError(t, err)
+	assert.False(t, disk.AttachedVMUUID.Valid)
 }
 
 func TestDeleteDisk(t *testing.T) {
@@ -71,6 +236,26 @@ func TestDeleteDisk(t *testing.T) {
 	bs.DeleteDisk(context.Background(), id)
 }
 
+func TestDeleteDisks(t *testing.T) {
+	ok, missing := uuid.New(), uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		if r.RequestURI == fmt.Sprintf("/v1/storage/disks/%s", missing) {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	err := bs.DeleteDisks(context.Background(), []uuid.UUID{ok, missing}, bulk.Options{Concurrency: 2})
+
+	var bulkErr *bulk.Error[uuid.UUID]
+	assert.ErrorAs(t, err, &bulkErr)
+	assert.Len(t, bulkErr.Errors, 1)
+	assert.Equal(t, missing, bulkErr.Errors[0].Key)
+	assert.ErrorIs(t, err, api.ErrNotFound)
+}
+
 func TestAttachDiskToVM(t *testing.T) {
 	diskId := uuid.New()
 	vmId := uuid.New()
@@ -119,3 +304,98 @@ func TestUpdateBucketBillingAccount(t *testing.T) {
 	bs := Client{API: a}
 	bs.UpdateDiskBillingAccount(context.Background(), id, 123)
 }
+
+func TestUpdateDisk(t *testing.T) {
+	id := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s", id), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, "data", r.Form.Get("name"))
+		assert.Equal(t, "postgres volume", r.Form.Get("description"))
+		_, ok := r.Form["billing_account_id"]
+		assert.False(t, ok)
+		w.Write([]byte(`{"name":"data","description":"postgres volume"}`))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.UpdateDisk(context.Background(), id, UpdateDiskConfig{Name: "data", Description: "postgres volume"})
+	assert.NoError(t, err)
+	assert.Equal(t, "data", disk.Name)
+	assert.Equal(t, "postgres volume", disk.Description)
+}
+
+func TestUpdateDisk_Empty(t *testing.T) {
+	bs := Client{}
+	disk, err := bs.UpdateDisk(context.Background(), uuid.New(), UpdateDiskConfig{})
+	assert.Nil(t, disk)
+	assert.EqualError(t, err, "UpdateDiskConfig with value of {Name: Description: BillingAccountID:0} is invalid, at least one field must be set")
+}
+
+func TestResizeDisk(t *testing.T) {
+	id := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s", id), r.RequestURI)
+		if r.Method == "GET" {
+			w.Write([]byte(`{"size_gb":20}`))
+			return
+		}
+		assert.Equal(t, "PATCH", r.Method)
+		_ = r.ParseForm()
+		assert.Equal(t, "40", r.Form.Get("size_gb"))
+		w.Write([]byte(`{"size_gb":40}`))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.ResizeDisk(context.Background(), id, 40)
+	assert.NoError(t, err)
+	assert.Equal(t, 40, disk.SizeGB)
+}
+
+func TestResizeDisk_Shrink(t *testing.T) {
+	id := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Write([]byte(`{"size_gb":20}`))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.ResizeDisk(context.Background(), id, 20)
+	assert.Nil(t, disk)
+	assert.EqualError(t, err, "SizeGB with value of 20 is invalid, must be larger than 20")
+}
+
+func TestWaitForDiskStatus(t *testing.T) {
+	id := uuid.New()
+	statuses := []string{"creating", "ready"}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s", id), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, id, statuses[0])))
+		statuses = statuses[1:]
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.WaitForDiskStatus(context.Background(), id, "ready", waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, "ready", disk.Status)
+}
+
+func TestListDisksIterator(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/storage/disks?limit=2&page=1", r.RequestURI)
+		w.Write([]byte("[{}]"))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	it := bs.ListDisksIterator(2)
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
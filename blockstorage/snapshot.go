@@ -0,0 +1,87 @@
+package blockstorage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+)
+
+// ListSnapshots https://api.warren.io/#list-snapshots
+func (c *Client) ListSnapshots(ctx context.Context, diskID uuid.UUID) (*[]Snapshot, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/storage/disks/%s/snapshots", diskID),
+	}
+	return api.Call[[]Snapshot](ctx, c.API, rc)
+}
+
+// CreateSnapshot https://api.warren.io/#create-snapshot
+func (c *Client) CreateSnapshot(ctx context.Context, diskID uuid.UUID) (*Snapshot, error) {
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/storage/disks/%s/snapshots", diskID),
+	}
+	return api.Call[Snapshot](ctx, c.API, rc)
+}
+
+// GetSnapshot https://api.warren.io/#get-snapshot
+func (c *Client) GetSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID) (*Snapshot, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/storage/disks/%s/snapshots/%s", diskID, snapshotID),
+	}
+	return api.Call[Snapshot](ctx, c.API, rc)
+}
+
+// RestoreSnapshot https://api.warren.io/#restore-snapshot
+func (c *Client) RestoreSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID) (*Disk, error) {
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/storage/disks/%s/snapshots/%s/restore", diskID, snapshotID),
+	}
+	return api.Call[Disk](ctx, c.API, rc)
+}
+
+// DeleteSnapshot https://api.warren.io/#delete-snapshot
+func (c *Client) DeleteSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/storage/disks/%s/snapshots/%s", diskID, snapshotID),
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
+
+// CreateDiskFromSnapshot creates a new disk from snapshot of diskID, cfg.SourceImageType and cfg.SourceImage are set from snapshot.
+// SizeGB defaults to snapshot size and can't be smaller than it.
+func (c *Client) CreateDiskFromSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID, cfg CreateDiskConfig) (*Disk, error) {
+	snapshot, err := c.GetSnapshot(ctx, diskID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.SizeGB == 0 {
+		cfg.SizeGB = snapshot.SizeGB
+	}
+	cfg.SourceImageType = ImageTypeSnapshot
+	cfg.SourceImage = snapshotID.String()
+	if err := cfg.ValidateSnapshot(*snapshot); err != nil {
+		return nil, err
+	}
+	return c.CreateDisk(ctx, cfg)
+}
+
+// WaitForSnapshot polls snapshot until it is ready or ctx is done, and returns the latest snapshot.
+func (c *Client) WaitForSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID, opts ...waiter.Option) (*Snapshot, error) {
+	var snapshot *Snapshot
+	err := waiter.ForStatus(ctx, func(ctx context.Context) (string, error) {
+		s, err := c.GetSnapshot(ctx, diskID, snapshotID)
+		if err != nil {
+			return "", err
+		}
+		snapshot = s
+		return s.Status, nil
+	}, SnapshotStatusReady, opts...)
+	return snapshot, err
+}
@@ -0,0 +1,148 @@
+package blockstorage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	diskID     uuid.UUID = uuid.MustParse("4e5eadd3-8b11-4c34-812a-2cf97120b628")
+	snapshotID uuid.UUID = uuid.MustParse("8d2d4e3a-5c86-4a4e-9d5b-0b3c4a0f2c11")
+)
+
+func TestListSnapshots(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s/snapshots", diskID), r.RequestURI)
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	bs.ListSnapshots(context.Background(), diskID)
+}
+
+func TestCreateSnapshot(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s/snapshots", diskID), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"creating","disk_uuid":"%s"}`, snapshotID, diskID)))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	snapshot, err := bs.CreateSnapshot(context.Background(), diskID)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshotID, snapshot.UUID)
+	assert.Equal(t, diskID, snapshot.DiskUUID)
+	assert.Equal(t, SnapshotStatusCreating, snapshot.Status)
+}
+
+func TestGetSnapshot(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s/snapshots/%s", diskID, snapshotID), r.RequestURI)
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	bs.GetSnapshot(context.Background(), diskID, snapshotID)
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s/snapshots/%s/restore", diskID, snapshotID), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"restoring"}`, diskID)))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.RestoreSnapshot(context.Background(), diskID, snapshotID)
+	assert.NoError(t, err)
+	assert.Equal(t, diskID, disk.UUID)
+}
+
+func TestDeleteSnapshot(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s/snapshots/%s", diskID, snapshotID), r.RequestURI)
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	bs.DeleteSnapshot(context.Background(), diskID, snapshotID)
+}
+
+func TestCreateDiskConfig_ValidateSnapshot(t *testing.T) {
+	snapshot := Snapshot{UUID: snapshotID, SizeGB: 20}
+
+	cfg := CreateDiskConfig{SizeGB: 20, SourceImageType: ImageTypeSnapshot, SourceImage: snapshotID.String()}
+	assert.NoError(t, cfg.ValidateSnapshot(snapshot))
+
+	cfg.SizeGB = 10
+	assert.EqualError(t, cfg.ValidateSnapshot(snapshot), "SizeGB with value of 10 is invalid, must be at least 20")
+
+	cfg.SourceImageType = ImageTypeDisk
+	assert.EqualError(t, cfg.ValidateSnapshot(snapshot), fmt.Sprintf("SourceImage with value of %s is invalid, must be snapshot %s", snapshotID, snapshotID))
+}
+
+func TestCreateDiskFromSnapshot(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s/snapshots/%s", diskID, snapshotID), r.RequestURI)
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","sizeGb":20}`, snapshotID)))
+			return
+		}
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/storage/disks", r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, "30", r.Form.Get("size_gb"))
+		assert.Equal(t, "123", r.Form.Get("billing_account_id"))
+		assert.Equal(t, "SNAPSHOT", r.Form.Get("source_image_type"))
+		assert.Equal(t, snapshotID.String(), r.Form.Get("source_image"))
+		w.Write([]byte(`{"size_gb":30}`))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.CreateDiskFromSnapshot(context.Background(), diskID, snapshotID, CreateDiskConfig{SizeGB: 30, BillingAccountID: 123})
+	assert.NoError(t, err)
+	assert.Equal(t, 30, disk.SizeGB)
+}
+
+func TestCreateDiskFromSnapshot_TooSmall(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","sizeGb":20}`, snapshotID)))
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	disk, err := bs.CreateDiskFromSnapshot(context.Background(), diskID, snapshotID, CreateDiskConfig{SizeGB: 10})
+	assert.Nil(t, disk)
+	assert.EqualError(t, err, "SizeGB with value of 10 is invalid, must be at least 20")
+}
+
+func TestWaitForSnapshot(t *testing.T) {
+	statuses := []string{SnapshotStatusCreating, SnapshotStatusReady}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v1/storage/disks/%s/snapshots/%s", diskID, snapshotID), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, snapshotID, statuses[0])))
+		statuses = statuses[1:]
+	})
+	defer s.Close()
+
+	bs := Client{API: a}
+	snapshot, err := bs.WaitForSnapshot(context.Background(), diskID, snapshotID, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, SnapshotStatusReady, snapshot.Status)
+}
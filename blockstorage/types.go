@@ -1,7 +1,11 @@
 package blockstorage
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/image"
 	"github.com/google/uuid"
 )
 
@@ -18,22 +22,172 @@ const (
 	ImageTypeEmpty    SourceImageType = "EMPTY"
 )
 
+// Snapshot statuses as reported by the API
+const (
+	SnapshotStatusCreating = "creating"
+	SnapshotStatusReady    = "ready"
+)
+
+// Snapshot is a point-in-time copy of a disk
 type Snapshot struct {
-	UUID      uuid.UUID `schema:"uuid"`
-	SizeGB    int       `schema:"sizeGb"`
-	CreatedAt string    `schema:"created_at"`
-	DiskUUID  uuid.UUID `schema:"disk_uuid"`
+	UUID      uuid.UUID `json:"uuid"`
+	Status    string    `json:"status"`
+	SizeGB    int       `json:"sizeGb"`
+	CreatedAt string    `json:"created_at"`
+	DiskUUID  uuid.UUID `json:"disk_uuid"`
 }
 
+// Disk represents block storage disk
+// AttachedVMUUID is invalid (null) when disk is not attached to any VM.
 type Disk struct {
-	UUID             uuid.UUID       `schema:"uuid"`
-	Status           string          `schema:"status"`
-	Snapshots        []Snapshot      `schema:"snapshots"`
-	UserID           int             `schema:"user_id"`
-	BillingAccountID int             `schema:"billing_account_id"`
+	UUID             uuid.UUID       `json:"uuid"`
+	Name             string          `json:"name"`
+	Description      string          `json:"description"`
+	Status           string          `json:"status"`
+	Snapshots        []Snapshot      `json:"snapshots"`
+	UserID           int             `json:"user_id"`
+	BillingAccountID int             `json:"billing_account_id"`
+	SizeGB           int             `json:"size_gb"`
+	SourceImageType  SourceImageType `json:"source_image_type"`
+	SourceImage      string          `json:"source_image"`
+	AttachedVMUUID   uuid.NullUUID   `json:"vm_uuid"`
+	CreatedAt        string          `json:"created_at"`
+	UpdatedAt        string          `json:"updated_at"`
+}
+
+// Fields disks can be sorted by, see `ListDisksOptions`
+const (
+	SortByCreatedAt = "created_at"
+	SortBySizeGB    = "size_gb"
+	SortByStatus    = "status"
+)
+
+// ListDisksOptions filters and sorts `Client.ListDisks()` results, zero values are ignored.
+// AttachedVMUUID lists disks attached to that VM while Unattached lists disks not attached to any VM,
+// only one of them can be set.
+type ListDisksOptions struct {
+	BillingAccountID int       `qs:"billing_account_id,omitempty"`
+	Status           string    `qs:"status,omitempty"`
+	AttachedVMUUID   uuid.UUID `qs:"vm_uuid,omitempty"`
+	Unattached       bool      `qs:"unattached,omitempty"`
+	SortBy           string
+	Desc             bool
+}
+
+// Validate checks that AttachedVMUUID and Unattached are not both set.
+func (o ListDisksOptions) Validate() error {
+	if o.AttachedVMUUID != uuid.Nil && o.Unattached {
+		return fmt.Errorf("AttachedVMUUID and Unattached with value of %v %v is invalid, only one can be set", o.AttachedVMUUID, o.Unattached)
+	}
+	return nil
+}
+
+// apply returns disks matching the filters, sorted when SortBy is set.
+func (o ListDisksOptions) apply(disks []Disk) []Disk {
+	filtered := []Disk{}
+	for _, d := range disks {
+		if o.BillingAccountID != 0 && d.BillingAccountID != o.BillingAccountID {
+			continue
+		}
+		if o.Status != "" && d.Status != o.Status {
+			continue
+		}
+		if o.AttachedVMUUID != uuid.Nil && d.AttachedVMUUID.UUID != o.AttachedVMUUID {
+			continue
+		}
+		if o.Unattached && d.AttachedVMUUID.Valid {
+			continue
+		}
+		filtered = append(filtered, d)
+	}
+
+	var less func(a, b Disk) bool
+	switch o.SortBy {
+	case SortByCreatedAt:
+		less = func(a, b Disk) bool { return a.CreatedAt < b.CreatedAt }
+	case SortBySizeGB:
+		less = func(a, b Disk) bool { return a.SizeGB < b.SizeGB }
+	case SortByStatus:
+		less = func(a, b Disk) bool { return a.Status < b.Status }
+	default:
+		return filtered
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if o.Desc {
+			return less(filtered[j], filtered[i])
+		}
+		return less(filtered[i], filtered[j])
+	})
+	return filtered
+}
+
+// CreateDiskConfig holds parameters to create a new disk
+type CreateDiskConfig struct {
 	SizeGB           int             `schema:"size_gb"`
+	BillingAccountID int             `schema:"billing_account_id"`
 	SourceImageType  SourceImageType `schema:"source_image_type"`
-	SourceImage      string          `schema:"source_image"`
-	CreatedAt        string          `schema:"created_at"`
-	UpdatedAt        string          `schema:"updated_at"`
+	SourceImage      string          `schema:"source_image,omitempty"`
+}
+
+// Validate checks fields that are required by the API, it's called by `Client.CreateDisk()` before sending the request.
+func (cfg CreateDiskConfig) Validate() error {
+	if cfg.SizeGB <= 0 {
+		return fmt.Errorf("SizeGB with value of %v is invalid, must be larger than 0", cfg.SizeGB)
+	}
+	switch cfg.SourceImageType {
+	case ImageTypeEmpty:
+		return nil
+	case ImageTypeOSBase, ImageTypeDisk, ImageTypeSnapshot:
+		if cfg.SourceImage == "" {
+			return fmt.Errorf("SourceImage with value of %q is invalid, must be set for %s", cfg.SourceImage, cfg.SourceImageType)
+		}
+		return nil
+	}
+	return fmt.Errorf("SourceImageType with value of %q is invalid, must be one of %s, %s, %s, %s",
+		cfg.SourceImageType, ImageTypeOSBase, ImageTypeDisk, ImageTypeSnapshot, ImageTypeEmpty)
+}
+
+// UpdateDiskConfig holds disk fields to change, empty fields are left unchanged.
+type UpdateDiskConfig struct {
+	Name             string `schema:"name,omitempty"`
+	Description      string `schema:"description,omitempty"`
+	BillingAccountID int    `schema:"billing_account_id,omitempty"`
+}
+
+// Validate checks that there's something to update, it's called by `Client.UpdateDisk()` before sending the request.
+func (cfg UpdateDiskConfig) Validate() error {
+	if cfg == (UpdateDiskConfig{}) {
+		return fmt.Errorf("UpdateDiskConfig with value of %+v is invalid, at least one field must be set", cfg)
+	}
+	if cfg.BillingAccountID < 0 {
+		return fmt.Errorf("BillingAccountID with value of %v is invalid", cfg.BillingAccountID)
+	}
+	return nil
+}
+
+// ValidateImage checks SourceImage against images catalog (see `image.Client.ListImages()`)
+// and that SizeGB is large enough for it. Snapshot and empty sources are not checked.
+func (cfg CreateDiskConfig) ValidateImage(images []image.Image) error {
+	if cfg.SourceImageType != ImageTypeOSBase && cfg.SourceImageType != ImageTypeDisk {
+		return nil
+	}
+	img := image.Find(images, cfg.SourceImage)
+	if img == nil || img.Type != string(cfg.SourceImageType) {
+		return fmt.Errorf("SourceImage with value of %v is invalid", cfg.SourceImage)
+	}
+	if cfg.SizeGB < img.MinDiskSizeGB {
+		return fmt.Errorf("SizeGB with value of %v is invalid, must be at least %d", cfg.SizeGB, img.MinDiskSizeGB)
+	}
+	return nil
+}
+
+// ValidateSnapshot checks that config creates disk from given snapshot and SizeGB fits the snapshot.
+func (cfg CreateDiskConfig) ValidateSnapshot(snapshot Snapshot) error {
+	if cfg.SourceImageType != ImageTypeSnapshot || cfg.SourceImage != snapshot.UUID.String() {
+		return fmt.Errorf("SourceImage with value of %v is invalid, must be snapshot %s", cfg.SourceImage, snapshot.UUID)
+	}
+	if cfg.SizeGB < snapshot.SizeGB {
+		return fmt.Errorf("SizeGB with value of %v is invalid, must be at least %d", cfg.SizeGB, snapshot.SizeGB)
+	}
+	return nil
 }
@@ -0,0 +1,89 @@
+// Package bulk runs the same operation over many resources with bounded concurrency.
+// Every call still goes through the API client, so its rate limiter and retry policy apply.
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DefaultConcurrency is number of workers used when Options.Concurrency is not set
+const DefaultConcurrency = 4
+
+// Options configures bulk operation
+type Options struct {
+	// Concurrency is maximum number of in-flight calls, default to DefaultConcurrency.
+	Concurrency int
+}
+
+// ItemError is failure of a single item
+type ItemError[K comparable] struct {
+	Key K
+	Err error
+}
+
+// Error aggregates failed items in the order they were given.
+type Error[K comparable] struct {
+	Total  int
+	Errors []ItemError[K]
+}
+
+func (e *Error[K]) Error() string {
+	first := e.Errors[0]
+	return fmt.Sprintf("%d of %d operations failed, first: %v: %v", len(e.Errors), e.Total, first.Key, first.Err)
+}
+
+// Unwrap makes `errors.Is()` and `errors.As()` match any of the item errors.
+func (e *Error[K]) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, ie := range e.Errors {
+		errs[i] = ie.Err
+	}
+	return errs
+}
+
+// Run calls fn for every key using up to opts.Concurrency workers and waits for all of them.
+// Keys not yet started when ctx is done fail with ctx error, nil ctx means `context.Background()`. It returns *Error when any item fails.
+func Run[K comparable](ctx context.Context, keys []K, opts Options, fn func(ctx context.Context, key K) error) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	n := opts.Concurrency
+	if n <= 0 {
+		n = DefaultConcurrency
+	}
+
+	errs := make([]error, len(keys))
+	idx := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < n && w < len(keys); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range idx {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fn(ctx, keys[i])
+			}
+		}()
+	}
+	for i := range keys {
+		idx <- i
+	}
+	close(idx)
+	wg.Wait()
+
+	var failed []ItemError[K]
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, ItemError[K]{Key: keys[i], Err: err})
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return &Error[K]{Total: len(keys), Errors: failed}
+}
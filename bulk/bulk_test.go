@@ -0,0 +1,75 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var inflight, peak int32
+	err := Run(context.Background(), []int{1, 2, 3, 4, 5, 6}, Options{Concurrency: 2}, func(ctx context.Context, k int) error {
+		n := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, peak, int32(2))
+}
+
+func TestRun_NilContext(t *testing.T) {
+	var done int32
+	err := Run(nil, []int{1, 2, 3}, Options{}, func(ctx context.Context, k int) error {
+		atomic.AddInt32(&done, 1)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), done)
+}
+
+func TestRun_Errors(t *testing.T) {
+	errOdd := errors.New("odd")
+	err := Run(context.Background(), []int{1, 2, 3, 4}, Options{}, func(ctx context.Context, k int) error {
+		if k%2 == 1 {
+			return errOdd
+		}
+		return nil
+	})
+
+	var bulkErr *Error[int]
+	assert.True(t, errors.As(err, &bulkErr))
+	assert.Equal(t, 4, bulkErr.Total)
+	assert.Equal(t, []ItemError[int]{{Key: 1, Err: errOdd}, {Key: 3, Err: errOdd}}, bulkErr.Errors)
+	assert.ErrorIs(t, err, errOdd)
+	assert.EqualError(t, err, "2 of 4 operations failed, first: 1: odd")
+}
+
+func TestRun_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	err := Run(ctx, []string{"a", "b"}, Options{}, func(ctx context.Context, k string) error {
+		called = true
+		return nil
+	})
+	assert.False(t, called)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRun_Empty(t *testing.T) {
+	assert.NoError(t, Run(context.Background(), nil, Options{}, func(ctx context.Context, k int) error {
+		return errors.New("never called")
+	}))
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var diskColumns = []string{"uuid", "status", "size_gb", "source_image_type", "source_image", "billing_account_id", "created_at"}
+
+func newDiskCmd(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disk",
+		Short: "Manage block storage disks",
+	}
+
+	var opts blockstorage.ListDisksOptions
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List disks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w, err := c.client()
+			if err != nil {
+				return err
+			}
+			disks, err := w.BlockStorage.ListDisks(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+			return c.print(cmd.OutOrStdout(), disks, diskColumns...)
+		},
+	}
+	list.Flags().StringVar(&opts.Status, "status", "", "only list disks with given status")
+	list.Flags().IntVar(&opts.BillingAccountID, "billing-account", 0, "only list disks of given billing account")
+	list.Flags().BoolVar(&opts.Unattached, "unattached", false, "only list disks not attached to any VM")
+	list.Flags().StringVar(&opts.SortBy, "sort", "", "sort by created_at, size_gb or status")
+	list.Flags().BoolVar(&opts.Desc, "desc", false, "sort in descending order")
+
+	var cfg blockstorage.CreateDiskConfig
+	var sourceType string
+	create := &cobra.Command{
+		Use:   "create",
+		Short: "Create a disk",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w, err := c.client()
+			if err != nil {
+				return err
+			}
+			cfg.SourceImageType = blockstorage.SourceImageType(sourceType)
+			if cfg.BillingAccountID == 0 {
+				cfg.BillingAccountID = w.BillingAccountID
+			}
+			d, err := w.BlockStorage.CreateDisk(cmd.Context(), cfg)
+			if err != nil {
+				return err
+			}
+			return c.print(cmd.OutOrStdout(), d, diskColumns...)
+		},
+	}
+	create.Flags().IntVar(&cfg.SizeGB, "size", 0, "disk size in GB")
+	create.Flags().IntVar(&cfg.BillingAccountID, "billing-account", 0, "billing account, defaults to the profile billing account")
+	create.Flags().StringVar(&sourceType, "source-image-type", string(blockstorage.ImageTypeEmpty), "OS_BASE, DISK, SNAPSHOT or EMPTY")
+	create.Flags().StringVar(&cfg.SourceImage, "source-image", "", "source image, required unless source image type is EMPTY")
+
+	get := &cobra.Command{
+		Use:   "get UUID",
+		Short: "Show a disk",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("UUID with value of %v is invalid", args[0])
+			}
+			w, err := c.client()
+			if err != nil {
+				return err
+			}
+			d, err := w.BlockStorage.GetDisk(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+			return c.print(cmd.OutOrStdout(), d, diskColumns...)
+		},
+	}
+
+	del := &cobra.Command{
+		Use:   "delete UUID",
+		Short: "Delete a disk",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("UUID with value of %v is invalid", args[0])
+			}
+			w, err := c.client()
+			if err != nil {
+				return err
+			}
+			return w.BlockStorage.DeleteDisk(cmd.Context(), id)
+		},
+	}
+
+	cmd.AddCommand(list, create, get, del)
+	return cmd
+}
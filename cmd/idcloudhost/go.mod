@@ -0,0 +1,21 @@
+module github.com/ekaputra07/warren-go/cmd/idcloudhost
+
+go 1.20
+
+require (
+	github.com/ekaputra07/warren-go v0.0.0-00010101000000-000000000000
+	github.com/google/uuid v1.6.0
+	github.com/spf13/cobra v1.8.1
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/gorilla/schema v1.4.1 // indirect
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/spf13/pflag v1.0.5 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/ekaputra07/warren-go => ../..
@@ -0,0 +1,20 @@
+// Command idcloudhost manages IDCloudHost (Warren) resources from the command line.
+//
+//	idcloudhost vm list --status running
+//	idcloudhost disk create --size 20 --billing-account 123
+//
+// Credentials and location are taken from the config file profile (see `config.Load()`),
+// use --profile to pick a non-default one and --location to override its location.
+package main
+
+import (
+	"os"
+
+	"github.com/ekaputra07/warren-go"
+)
+
+func main() {
+	if err := newRootCmd(warren.NewClientFromProfile).Execute(); err != nil {
+		os.Exit(1)
+	}
+}
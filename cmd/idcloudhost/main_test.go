@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ekaputra07/warren-go"
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/ekaputra07/warren-go/vm"
+	"github.com/ekaputra07/warren-go/warrentest"
+	"github.com/stretchr/testify/assert"
+)
+
+// run executes CLI against warrentest server and returns its output.
+func run(t *testing.T, s *warrentest.Server, args ...string) (string, error) {
+	t.Helper()
+	cmd := newRootCmd(func(profile string, opts ...api.Option) (*warren.Warren, error) {
+		w := s.Warren("jkt01", opts...)
+		w.BillingAccountID = 123
+		return w, nil
+	})
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestVMList(t *testing.T) {
+	s := warrentest.NewServer()
+	defer s.Close()
+	s.AddVM(vm.VM{Name: "web-1", Status: vm.StatusRunning})
+	s.AddVM(vm.VM{Name: "sql-1", Status: vm.StatusStopped})
+
+	out, err := run(t, s, "vm", "list", "--status", "running")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "NAME")
+	assert.Contains(t, out, "web-1")
+	assert.NotContains(t, out, "sql-1")
+
+	out, err = run(t, s, "vm", "list", "-o", "json")
+	assert.NoError(t, err)
+	var summaries []vm.Summary
+	assert.NoError(t, json.Unmarshal([]byte(out), &summaries))
+	assert.Len(t, summaries, 2)
+}
+
+func TestVMStop(t *testing.T) {
+	s := warrentest.NewServer()
+	defer s.Close()
+	v := s.AddVM(vm.VM{Name: "web-1", Status: vm.StatusRunning})
+
+	_, err := run(t, s, "vm", "stop", v.UUID.String())
+	assert.NoError(t, err)
+	assert.Equal(t, vm.StatusStopped, s.VMs()[0].Status)
+
+	_, err = run(t, s, "vm", "stop", "abc")
+	assert.EqualError(t, err, "UUID with value of abc is invalid")
+}
+
+func TestDiskCreate(t *testing.T) {
+	s := warrentest.NewServer()
+	defer s.Close()
+
+	_, err := run(t, s, "disk", "create", "--size", "20")
+	assert.NoError(t, err)
+	disks := s.Disks()
+	assert.Len(t, disks, 1)
+	assert.Equal(t, 20, disks[0].SizeGB)
+	assert.Equal(t, 123, disks[0].BillingAccountID)
+	assert.Equal(t, blockstorage.ImageTypeEmpty, disks[0].SourceImageType)
+
+	_, err = run(t, s, "disk", "create")
+	assert.EqualError(t, err, "SizeGB with value of 0 is invalid, must be larger than 0")
+}
+
+func TestOutputFlag(t *testing.T) {
+	s := warrentest.NewServer()
+	defer s.Close()
+
+	s.AddDisk(blockstorage.Disk{SizeGB: 20})
+
+	out, err := run(t, s, "disk", "list", "-o", "yaml")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "size_gb: 20")
+
+	_, err = run(t, s, "disk", "list", "-o", "xml")
+	assert.EqualError(t, err, `Format with value of "xml" is invalid, must be one of json, yaml, table`)
+}
+
+func TestDryRun(t *testing.T) {
+	s := warrentest.NewServer()
+	defer s.Close()
+	v := s.AddVM(vm.VM{Name: "web-1", Status: vm.StatusRunning})
+
+	out, err := run(t, s, "vm", "delete", v.UUID.String(), "--dry-run")
+	assert.NoError(t, err)
+	assert.Contains(t, out, "dry run: DELETE ")
+	assert.Len(t, s.VMs(), 1)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"io"
+
+	"github.com/ekaputra07/warren-go"
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/format"
+	"github.com/spf13/cobra"
+)
+
+// clientFunc creates Warren from named profile, `warren.NewClientFromProfile()` outside of tests.
+type clientFunc func(profile string, opts ...api.Option) (*warren.Warren, error)
+
+// cli holds global flags shared by all subcommands.
+type cli struct {
+	newClient clientFunc
+	profile   string
+	location  string
+	output    string
+	format    format.Format
+	dryRun    bool
+	stderr    io.Writer
+}
+
+func newRootCmd(newClient clientFunc) *cobra.Command {
+	c := &cli{newClient: newClient}
+	cmd := &cobra.Command{
+		Use:          "idcloudhost",
+		Short:        "Manage IDCloudHost resources",
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			f, err := format.Parse(c.output)
+			c.format = f
+			c.stderr = cmd.ErrOrStderr()
+			return err
+		},
+	}
+	cmd.PersistentFlags().StringVar(&c.profile, "profile", "", "config file profile, empty means the default profile")
+	cmd.PersistentFlags().StringVar(&c.location, "location", "", "data center location, overrides the profile location")
+	cmd.PersistentFlags().StringVarP(&c.output, "output", "o", string(format.Table), "output format, table, json or yaml")
+	cmd.PersistentFlags().BoolVar(&c.dryRun, "dry-run", false, "print changes that would be made without making them")
+
+	cmd.AddCommand(newVMCmd(c), newDiskCmd(c))
+	return cmd
+}
+
+// client returns Warren for the selected profile and location.
+func (c *cli) client() (*warren.Warren, error) {
+	var opts []api.Option
+	if c.dryRun {
+		opts = append(opts, api.WithDryRun(c.stderr))
+	}
+	w, err := c.newClient(c.profile, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if c.location != "" {
+		w = w.WithLocation(c.location)
+	}
+	return w, nil
+}
+
+// print writes v in the selected output format, columns are JSON field names shown in table.
+func (c *cli) print(w io.Writer, v interface{}, columns ...string) error {
+	return format.Write(w, c.format, v, columns...)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go"
+	"github.com/ekaputra07/warren-go/vm"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// Table columns of VM and its summary
+var (
+	vmColumns        = []string{"uuid", "name", "status", "vcpu", "memory", "os_name", "os_version", "private_ipv4"}
+	vmSummaryColumns = []string{"uuid", "name", "status", "vcpu", "ram", "os_name", "os_version", "private_ipv4"}
+)
+
+func newVMCmd(c *cli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vm",
+		Short: "Manage virtual machines",
+	}
+
+	var opts vm.ListVMsOptions
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List VMs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			w, err := c.client()
+			if err != nil {
+				return err
+			}
+			summaries, err := w.VM.ListVMSummaries(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+			return c.print(cmd.OutOrStdout(), summaries, vmSummaryColumns...)
+		},
+	}
+	list.Flags().StringVar(&opts.Status, "status", "", "only list VMs with given status")
+	list.Flags().StringVar(&opts.NamePrefix, "name-prefix", "", "only list VMs whose name starts with given prefix")
+	list.Flags().IntVar(&opts.BillingAccountID, "billing-account", 0, "only list VMs of given billing account")
+
+	cmd.AddCommand(
+		list,
+		vmActionCmd(c, "get", "Show a VM", warren.VMService.GetVM),
+		vmActionCmd(c, "start", "Start a VM", warren.VMService.StartVM),
+		vmActionCmd(c, "stop", "Stop a VM", warren.VMService.StopVM),
+		vmActionCmd(c, "reboot", "Reboot a VM", warren.VMService.RebootVM),
+		&cobra.Command{
+			Use:   "delete UUID",
+			Short: "Delete a VM",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				id, err := uuid.Parse(args[0])
+				if err != nil {
+					return fmt.Errorf("UUID with value of %v is invalid", args[0])
+				}
+				w, err := c.client()
+				if err != nil {
+					return err
+				}
+				return w.VM.DeleteVM(cmd.Context(), id)
+			},
+		},
+	)
+	return cmd
+}
+
+// vmActionCmd creates subcommand that calls fn with VM UUID argument and prints the resulting VM.
+func vmActionCmd(c *cli, name, short string, fn func(warren.VMService, context.Context, uuid.UUID) (*vm.VM, error)) *cobra.Command {
+	return &cobra.Command{
+		Use:   name + " UUID",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("UUID with value of %v is invalid", args[0])
+			}
+			w, err := c.client()
+			if err != nil {
+				return err
+			}
+			v, err := fn(w.VM, cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+			return c.print(cmd.OutOrStdout(), v, vmColumns...)
+		},
+	}
+}
@@ -0,0 +1,150 @@
+// Package config loads client settings from a config file with named profiles:
+//
+//	[default]
+//	api_key = secret
+//	base_url = https://api.idcloudhost.com
+//	location = jkt01
+//	billing_account_id = 123
+//
+//	[staging]
+//	api_key = other-secret
+//	base_url = https://staging.example.com
+//
+// The file is read from `~/.idcloudhost/config` unless WARREN_CONFIG_FILE is set.
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	configFileEnvKey string = "WARREN_CONFIG_FILE"
+
+	// DefaultProfile is used when profile name is empty
+	DefaultProfile = "default"
+)
+
+// Profile holds settings to create a client
+type Profile struct {
+	Name             string
+	APIKey           string
+	BaseURL          string
+	Location         string
+	BillingAccountID int
+}
+
+// Config holds profiles by name
+type Config map[string]Profile
+
+// Profile returns profile with given name, or the default one when name is empty.
+func (c Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = DefaultProfile
+	}
+	p, ok := c[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %s not found", name)
+	}
+	return &p, nil
+}
+
+// Path returns config file location, from WARREN_CONFIG_FILE or `~/.idcloudhost/config`.
+func Path() (string, error) {
+	if p := os.Getenv(configFileEnvKey); p != "" {
+		return p, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".idcloudhost", "config"), nil
+}
+
+// Load reads config from `Path()`.
+func Load() (Config, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	return LoadFile(path)
+}
+
+// LoadFile reads config from given file.
+func LoadFile(path string) (Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cfg, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Parse reads config in INI format, lines starting with # or ; are comments.
+func Parse(r io.Reader) (Config, error) {
+	cfg := Config{}
+	var current *Profile
+	s := bufio.NewScanner(r)
+	for n := 1; s.Scan(); n++ {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			if current != nil {
+				cfg[current.Name] = *current
+			}
+			current = &Profile{Name: strings.TrimSpace(line[1 : len(line)-1])}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", n)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: %s outside of profile", n, strings.TrimSpace(key))
+		}
+		if err := current.set(strings.TrimSpace(key), strings.TrimSpace(value)); err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if current != nil {
+		cfg[current.Name] = *current
+	}
+	return cfg, nil
+}
+
+func (p *Profile) set(key, value string) error {
+	switch key {
+	case "api_key":
+		p.APIKey = value
+	case "base_url":
+		p.BaseURL = value
+	case "location":
+		p.Location = value
+	case "billing_account_id":
+		id, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("billing_account_id with value of %v is invalid", value)
+		}
+		p.BillingAccountID = id
+	default:
+		return fmt.Errorf("unknown key %s", key)
+	}
+	return nil
+}
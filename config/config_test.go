@@ -0,0 +1,90 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sample = `
+# comment
+[default]
+api_key = secret
+base_url = https://api.idcloudhost.com
+location = jkt01
+billing_account_id = 123
+
+; another comment
+[staging]
+api_key = other = secret
+base_url = https://staging.example.com
+`
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse(strings.NewReader(sample))
+	assert.NoError(t, err)
+	assert.Equal(t, Config{
+		"default": {
+			Name:             "default",
+			APIKey:           "secret",
+			BaseURL:          "https://api.idcloudhost.com",
+			Location:         "jkt01",
+			BillingAccountID: 123,
+		},
+		"staging": {
+			Name:    "staging",
+			APIKey:  "other = secret",
+			BaseURL: "https://staging.example.com",
+		},
+	}, cfg)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse(strings.NewReader("api_key = secret"))
+	assert.EqualError(t, err, "line 1: api_key outside of profile")
+
+	_, err = Parse(strings.NewReader("[default]\napi_key"))
+	assert.EqualError(t, err, "line 2: expected key = value")
+
+	_, err = Parse(strings.NewReader("[default]\nbilling_account_id = abc"))
+	assert.EqualError(t, err, "line 2: billing_account_id with value of abc is invalid")
+
+	_, err = Parse(strings.NewReader("[default]\nregion = jkt01"))
+	assert.EqualError(t, err, "line 2: unknown key region")
+}
+
+func TestConfig_Profile(t *testing.T) {
+	cfg := Config{"default": {Name: "default"}, "staging": {Name: "staging"}}
+
+	p, err := cfg.Profile("")
+	assert.NoError(t, err)
+	assert.Equal(t, "default", p.Name)
+
+	p, err = cfg.Profile("staging")
+	assert.NoError(t, err)
+	assert.Equal(t, "staging", p.Name)
+
+	_, err = cfg.Profile("prod")
+	assert.EqualError(t, err, "profile prod not found")
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	assert.NoError(t, os.WriteFile(path, []byte(sample), 0600))
+	t.Setenv("WARREN_CONFIG_FILE", path)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Len(t, cfg, 2)
+}
+
+func TestPath(t *testing.T) {
+	t.Setenv("WARREN_CONFIG_FILE", "")
+	t.Setenv("HOME", "/home/test")
+	p, err := Path()
+	assert.NoError(t, err)
+	assert.Equal(t, "/home/test/.idcloudhost/config", p)
+}
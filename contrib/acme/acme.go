@@ -0,0 +1,122 @@
+// Package acme solves ACME DNS-01 challenges with TXT records in Warren DNS zones (see `dns` module),
+// so certificates (e.g. from Let's Encrypt) can be obtained for domains hosted there. With lego:
+//
+//	w := warren.New()
+//	client.Challenge.SetDNS01Provider(acme.NewDNSProvider(w.DNS))
+//
+// DNSProvider implements lego's `challenge.Provider` and `challenge.ProviderTimeout` interfaces,
+// it only relies on their method sets so lego is not a dependency of this module.
+package acme
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	warren "github.com/ekaputra07/warren-go"
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/dns"
+)
+
+// Defaults of DNSProvider created by `NewDNSProvider()`
+const (
+	DefaultTTL                = dns.MinTTL
+	DefaultPropagationTimeout = 5 * time.Minute
+	DefaultPollingInterval    = 10 * time.Second
+)
+
+// lego's challenge.Provider and challenge.ProviderTimeout
+var _ interface {
+	Present(domain, token, keyAuth string) error
+	CleanUp(domain, token, keyAuth string) error
+	Timeout() (timeout, interval time.Duration)
+} = (*DNSProvider)(nil)
+
+// DNSProvider creates challenge TXT record in the zone serving the domain, and deletes it once challenge is done.
+// Several challenges of the same name (e.g. for example.com and *.example.com) can be solved at the same time.
+// PropagationTimeout and PollingInterval tell the ACME client how long and how often to check the record is live.
+type DNSProvider struct {
+	DNS                warren.DNSService
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+}
+
+// NewDNSProvider returns DNSProvider managing records with given client, usually `warren.Warren.DNS`.
+func NewDNSProvider(client warren.DNSService) *DNSProvider {
+	return &DNSProvider{
+		DNS:                client,
+		TTL:                DefaultTTL,
+		PropagationTimeout: DefaultPropagationTimeout,
+		PollingInterval:    DefaultPollingInterval,
+	}
+}
+
+// ChallengeRecord returns FQDN (with trailing dot) and value of TXT record solving DNS-01 challenge of domain,
+// as described in RFC 8555 section 8.4.
+func ChallengeRecord(domain, keyAuth string) (fqdn, value string) {
+	domain = strings.TrimSuffix(strings.TrimPrefix(domain, "*."), ".")
+	sum := sha256.Sum256([]byte(keyAuth))
+	return fmt.Sprintf("_acme-challenge.%s.", domain), base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Present creates TXT record solving the challenge, existing record with the same value is reused.
+func (p *DNSProvider) Present(domain, token, keyAuth string) error {
+	ctx := context.Background()
+	zone, cfg, err := p.record(ctx, domain, keyAuth)
+	if err != nil {
+		return err
+	}
+	if _, err := p.DNS.UpsertRecords(ctx, zone.UUID, []dns.RecordConfig{cfg}); err != nil {
+		return fmt.Errorf("acme: create record %s in zone %s: %w", cfg.Name, zone.Name, err)
+	}
+	return nil
+}
+
+// CleanUp deletes TXT record created by `Present()`, other records of the same name are left untouched.
+func (p *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	ctx := context.Background()
+	zone, cfg, err := p.record(ctx, domain, keyAuth)
+	if err != nil {
+		return err
+	}
+	records, err := p.DNS.ListRecords(ctx, zone.UUID)
+	if err != nil {
+		return fmt.Errorf("acme: list records of zone %s: %w", zone.Name, err)
+	}
+	for _, r := range *records {
+		if !strings.EqualFold(r.Type, dns.TypeTXT) || !strings.EqualFold(r.Name, cfg.Name) || r.Content != cfg.Content {
+			continue
+		}
+		// already gone is as good as deleted
+		if err := p.DNS.DeleteRecord(ctx, zone.UUID, r.UUID); err != nil && !errors.Is(err, api.ErrNotFound) {
+			return fmt.Errorf("acme: delete record %s in zone %s: %w", cfg.Name, zone.Name, err)
+		}
+	}
+	return nil
+}
+
+// Timeout returns PropagationTimeout and PollingInterval.
+func (p *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return p.PropagationTimeout, p.PollingInterval
+}
+
+// record returns zone serving the challenge record of domain and the record itself.
+func (p *DNSProvider) record(ctx context.Context, domain, keyAuth string) (*dns.Zone, dns.RecordConfig, error) {
+	fqdn, value := ChallengeRecord(domain, keyAuth)
+	zone, err := p.DNS.FindZone(ctx, fqdn)
+	if err != nil {
+		return nil, dns.RecordConfig{}, fmt.Errorf("acme: find zone of %s: %w", fqdn, err)
+	}
+	cfg := dns.RecordConfig{
+		Type:    dns.TypeTXT,
+		Name:    dns.RelativeName(fqdn, zone.Name),
+		Content: value,
+		TTL:     p.TTL,
+	}
+	return zone, cfg, nil
+}
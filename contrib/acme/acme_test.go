@@ -0,0 +1,86 @@
+package acme
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/dns"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var zoneID = uuid.MustParse("3f1c2b6e-8a4d-4e2f-9b7a-5c6d7e8f9a0b")
+
+func TestChallengeRecord(t *testing.T) {
+	// value from RFC 8555 example key authorization
+	fqdn, value := ChallengeRecord("*.www.example.com.", "evaGxfADs6pSRb2LAv9IZf17Dt3juxGJ-PCt92wr-oA.nP1qzpXGymHBrUEepNY9HCsQk7K8KhOypzEt62jcerQ")
+	assert.Equal(t, "_acme-challenge.www.example.com.", fqdn)
+	assert.Len(t, value, 43)
+	assert.NotContains(t, value, "=")
+}
+
+// server is fake DNS API holding records of a single zone example.com
+type server struct {
+	records []dns.Record
+	deleted []uuid.UUID
+}
+
+func (s *server) handle(w http.ResponseWriter, r *http.Request) {
+	records := fmt.Sprintf("/v1/dns/zones/%s/records", zoneID)
+	switch {
+	case r.URL.Path == "/v1/dns/zones":
+		fmt.Fprintf(w, `[{"uuid":"%s","name":"example.com"},{"uuid":"%s","name":"example.org"}]`, zoneID, uuid.New())
+	case r.URL.Path == records && r.Method == "GET":
+		json.NewEncoder(w).Encode(s.records)
+	case r.URL.Path == records && r.Method == "POST":
+		var cfg dns.RecordConfig
+		_ = json.NewDecoder(r.Body).Decode(&cfg)
+		rec := dns.Record{UUID: uuid.New(), Type: cfg.Type, Name: cfg.Name, Content: cfg.Content, TTL: cfg.TTL}
+		s.records = append(s.records, rec)
+		json.NewEncoder(w).Encode(rec)
+	case r.Method == "DELETE":
+		id := uuid.MustParse(r.URL.Path[len(records)+1:])
+		s.deleted = append(s.deleted, id)
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func TestDNSProvider(t *testing.T) {
+	srv := &server{records: []dns.Record{{UUID: uuid.New(), Type: dns.TypeTXT, Name: "_acme-challenge.www", Content: "other"}}}
+	a, s := api.MockClientServer(srv.handle)
+	defer s.Close()
+
+	p := NewDNSProvider(dns.NewClient(a))
+	assert.NoError(t, p.Present("www.example.com", "token", "key-1"))
+	assert.NoError(t, p.Present("*.www.example.com", "token", "key-2"))
+	// retried Present doesn't duplicate the record
+	assert.NoError(t, p.Present("www.example.com", "token", "key-1"))
+	assert.Len(t, srv.records, 3)
+
+	_, value := ChallengeRecord("www.example.com", "key-1")
+	created := srv.records[1]
+	assert.Equal(t, dns.RecordConfig{Type: dns.TypeTXT, Name: "_acme-challenge.www", Content: value, TTL: DefaultTTL}, created.Config())
+
+	// only the record of this challenge is deleted, not found is ignored
+	assert.NoError(t, p.CleanUp("www.example.com", "token", "key-1"))
+	assert.Equal(t, []uuid.UUID{created.UUID}, srv.deleted)
+
+	timeout, interval := p.Timeout()
+	assert.Equal(t, DefaultPropagationTimeout, timeout)
+	assert.Equal(t, DefaultPollingInterval, interval)
+}
+
+func TestDNSProvider_NoZone(t *testing.T) {
+	a, s := api.MockClientServer((&server{}).handle)
+	defer s.Close()
+
+	p := NewDNSProvider(dns.NewClient(a))
+	err := p.Present("example.net", "token", "key")
+	assert.ErrorIs(t, err, api.ErrNotFound)
+	assert.ErrorContains(t, err, "acme: find zone of _acme-challenge.example.net.")
+}
@@ -0,0 +1,18 @@
+module github.com/ekaputra07/warren-go/contrib/acme
+
+go 1.20
+
+require (
+	github.com/ekaputra07/warren-go v0.0.0-00010101000000-000000000000
+	github.com/google/uuid v1.6.0
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/gorilla/schema v1.4.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/ekaputra07/warren-go => ../..
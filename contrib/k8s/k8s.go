@@ -0,0 +1,35 @@
+// Package k8s turns kubeconfig of Warren managed Kubernetes cluster into client-go configs.
+//
+//	k := kubernetes.NewClient(api.Default, "jkt01")
+//	cfg, err := k8s.RESTConfig(ctx, k, clusterUUID)
+//	clientset, err := k8sclient.NewForConfig(cfg)
+package k8s
+
+import (
+	"context"
+
+	"github.com/ekaputra07/warren-go/kubernetes"
+	"github.com/google/uuid"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// ClientConfig downloads kubeconfig of the cluster and parses it into `clientcmd.ClientConfig`,
+// useful when namespace or raw config is needed besides `rest.Config`.
+func ClientConfig(ctx context.Context, c *kubernetes.Client, id uuid.UUID) (clientcmd.ClientConfig, error) {
+	b, err := c.GetKubeconfig(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return clientcmd.NewClientConfigFromBytes(b)
+}
+
+// RESTConfig downloads kubeconfig of the cluster and returns `rest.Config` for its current context,
+// ready to be passed to client-go clientsets.
+func RESTConfig(ctx context.Context, c *kubernetes.Client, id uuid.UUID) (*rest.Config, error) {
+	cc, err := ClientConfig(ctx, c, id)
+	if err != nil {
+		return nil, err
+	}
+	return cc.ClientConfig()
+}
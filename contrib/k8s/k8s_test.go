@@ -0,0 +1,73 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/kubernetes"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	loc string    = "jkt01"
+	id  uuid.UUID = uuid.MustParse("4e5eadd3-8b11-4c34-812a-2cf97120b628")
+)
+
+const kubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: https://10.0.0.1:6443
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: admin
+    namespace: apps
+current-context: test
+users:
+- name: admin
+  user:
+    token: secret-token
+`
+
+func TestRESTConfig(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/kubeconfig", loc, id), r.RequestURI)
+		w.Write([]byte(kubeconfig))
+	})
+	defer s.Close()
+
+	cfg, err := RESTConfig(context.Background(), kubernetes.NewClient(a, loc), id)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://10.0.0.1:6443", cfg.Host)
+	assert.Equal(t, "secret-token", cfg.BearerToken)
+}
+
+func TestClientConfig(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(kubeconfig))
+	})
+	defer s.Close()
+
+	cc, err := ClientConfig(context.Background(), kubernetes.NewClient(a, loc), id)
+	assert.NoError(t, err)
+	ns, _, err := cc.Namespace()
+	assert.NoError(t, err)
+	assert.Equal(t, "apps", ns)
+}
+
+func TestRESTConfig_Error(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer s.Close()
+
+	_, err := RESTConfig(context.Background(), kubernetes.NewClient(a, loc), id)
+	assert.ErrorIs(t, err, api.ErrNotFound)
+}
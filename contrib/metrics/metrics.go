@@ -0,0 +1,58 @@
+// Package metrics exposes Warren API call metrics to Prometheus.
+//
+//	m := metrics.NewPrometheus(prometheus.DefaultRegisterer, "myapp")
+//	a := api.New(baseURL, apiKey, api.WithMetrics(m))
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Prometheus implements `api.MetricsRecorder` with following metrics:
+//   - warren_requests_total{method, path, status}
+//   - warren_request_errors_total{method, path, status}
+//   - warren_request_duration_seconds{method, path}
+//
+// Status is "0" for calls that failed without response.
+type Prometheus struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewPrometheus creates and registers collectors to reg, namespace is prepended to metric names when set.
+func NewPrometheus(reg prometheus.Registerer, namespace string) *Prometheus {
+	p := &Prometheus{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "warren_requests_total",
+			Help:      "Number of Warren API calls.",
+		}, []string{"method", "path", "status"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "warren_request_errors_total",
+			Help:      "Number of failed Warren API calls.",
+		}, []string{"method", "path", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "warren_request_duration_seconds",
+			Help:      "Duration of Warren API calls, including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "path"}),
+	}
+	reg.MustRegister(p.requests, p.errors, p.duration)
+	return p
+}
+
+// RecordCall implements `api.MetricsRecorder`.
+func (p *Prometheus) RecordCall(method, path string, statusCode int, duration time.Duration, err error) {
+	status := strconv.Itoa(statusCode)
+	p.requests.WithLabelValues(method, path, status).Inc()
+	p.duration.WithLabelValues(method, path).Observe(duration.Seconds())
+	if err != nil {
+		p.errors.WithLabelValues(method, path, status).Inc()
+	}
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheus(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("[]"))
+	})
+	defer s.Close()
+
+	reg := prometheus.NewRegistry()
+	p := NewPrometheus(reg, "test")
+	api.WithMetrics(p)(a)
+
+	a.FormRequest(context.Background(), api.RequestConfig{Method: "GET", Path: "/v1/storage/disks"})
+	a.FormRequest(context.Background(), api.RequestConfig{Method: "GET", Path: "/v1/storage/disks"})
+	a.FormRequest(context.Background(), api.RequestConfig{Method: "DELETE", Path: "/v1/storage/disks/4e5eadd3-8b11-4c34-812a-2cf97120b628"})
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(p.requests.WithLabelValues("GET", "/v1/storage/disks", "200")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(p.requests.WithLabelValues("DELETE", "/v1/storage/disks/{uuid}", "404")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(p.errors.WithLabelValues("DELETE", "/v1/storage/disks/{uuid}", "404")))
+	assert.Equal(t, 2, testutil.CollectAndCount(p.duration))
+}
@@ -0,0 +1,71 @@
+// Package warrenotel creates OpenTelemetry spans for Warren API calls.
+//
+//	a := api.New(baseURL, apiKey, api.WithHook(warrenotel.NewHook()))
+package warrenotel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/ekaputra07/warren-go/contrib/warrenotel"
+
+// Hook implements `api.Hook`, creating a client span around every API call.
+type Hook struct {
+	tracer trace.Tracer
+}
+
+// Option configures Hook.
+type Option func(*config)
+
+type config struct {
+	provider trace.TracerProvider
+}
+
+// WithTracerProvider sets TracerProvider to create spans from, default to the global one.
+func WithTracerProvider(p trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = p
+	}
+}
+
+// NewHook creates Hook to be registered with `api.WithHook()`.
+func NewHook(opts ...Option) *Hook {
+	cfg := config{provider: otel.GetTracerProvider()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &Hook{tracer: cfg.provider.Tracer(instrumentationName)}
+}
+
+// BeforeCall starts the span, named after the method and path template.
+func (h *Hook) BeforeCall(ctx context.Context, info api.CallInfo) context.Context {
+	ctx, _ = h.tracer.Start(ctx, fmt.Sprintf("%s %s", info.Method, info.Path),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", info.Method),
+			attribute.String("url.template", info.Path),
+		),
+	)
+	return ctx
+}
+
+// AfterCall records call result and ends the span.
+func (h *Hook) AfterCall(ctx context.Context, info api.CallInfo) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("warren.retry_count", info.Retries))
+	if info.StatusCode != 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", info.StatusCode))
+	}
+	if info.Err != nil {
+		span.RecordError(info.Err)
+		span.SetStatus(codes.Error, info.Err.Error())
+	}
+	span.End()
+}
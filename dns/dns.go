@@ -0,0 +1,185 @@
+// Package dns manages DNS zones and their records.
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+func NewClient(client *api.API) *Client {
+	return &Client{
+		API: client,
+	}
+}
+
+// ListZones https://api.warren.io/#list-dns-zones
+func (c *Client) ListZones(ctx context.Context) (*[]Zone, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/dns/zones",
+	}
+	return api.Call[[]Zone](ctx, c.API, rc)
+}
+
+// GetZone https://api.warren.io/#get-dns-zone
+func (c *Client) GetZone(ctx context.Context, id uuid.UUID) (*Zone, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/dns/zones/%s", id),
+	}
+	return api.Call[Zone](ctx, c.API, rc)
+}
+
+// FindZone returns zone serving fqdn, the one with the longest matching name when there are several
+// (e.g. "dev.example.com" is picked over "example.com" for "www.dev.example.com"). Error wraps `api.ErrNotFound`
+// when there's none.
+func (c *Client) FindZone(ctx context.Context, fqdn string) (*Zone, error) {
+	zones, err := c.ListZones(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var found *Zone
+	for i := range *zones {
+		z := &(*zones)[i]
+		if inZone(fqdn, z.Name) && (found == nil || len(z.Name) > len(found.Name)) {
+			found = z
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("zone of %s: %w", fqdn, api.ErrNotFound)
+	}
+	return found, nil
+}
+
+// CreateZone https://api.warren.io/#create-dns-zone
+// Point the domain to returned NameServers at the registrar for records to take effect.
+func (c *Client) CreateZone(ctx context.Context, name string) (*Zone, error) {
+	name = strings.TrimSuffix(name, ".")
+	if !strings.Contains(name, ".") {
+		return nil, fmt.Errorf("Name with value of %q is invalid, must be a domain name", name)
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   "/v1/dns/zones",
+		JSON:   map[string]interface{}{"name": name},
+	}
+	return api.CallJSON[Zone](ctx, c.API, rc)
+}
+
+// DeleteZone https://api.warren.io/#delete-dns-zone
+// All records of the zone are deleted along.
+func (c *Client) DeleteZone(ctx context.Context, id uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/dns/zones/%s", id),
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
+
+// ListRecords https://api.warren.io/#list-dns-records
+func (c *Client) ListRecords(ctx context.Context, zoneID uuid.UUID) (*[]Record, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/dns/zones/%s/records", zoneID),
+	}
+	return api.Call[[]Record](ctx, c.API, rc)
+}
+
+// CreateRecord https://api.warren.io/#create-dns-record
+func (c *Client) CreateRecord(ctx context.Context, zoneID uuid.UUID, cfg RecordConfig) (*Record, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	cfg.Type = strings.ToUpper(cfg.Type)
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/dns/zones/%s/records", zoneID),
+		JSON:   cfg,
+	}
+	return api.CallJSON[Record](ctx, c.API, rc)
+}
+
+// UpdateRecord https://api.warren.io/#update-dns-record
+func (c *Client) UpdateRecord(ctx context.Context, zoneID, recordID uuid.UUID, cfg RecordConfig) (*Record, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	cfg.Type = strings.ToUpper(cfg.Type)
+	rc := api.RequestConfig{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/dns/zones/%s/records/%s", zoneID, recordID),
+		JSON:   cfg,
+	}
+	return api.CallJSON[Record](ctx, c.API, rc)
+}
+
+// DeleteRecord https://api.warren.io/#delete-dns-record
+func (c *Client) DeleteRecord(ctx context.Context, zoneID, recordID uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/dns/zones/%s/records/%s", zoneID, recordID),
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
+
+// UpsertRecords creates records that don't exist yet and updates TTL (unless zero) and priority of those that do,
+// other records are left untouched. Records are matched by type, name and content, except CNAME
+// which is matched by name only and has its content updated. It returns records as they're after the call.
+//
+// It's meant for automation such as DNS-01 challenges where several TXT values of the same name must coexist:
+//
+//	dns.RecordConfig{Type: dns.TypeTXT, Name: "_acme-challenge", Content: token, TTL: dns.MinTTL}
+func (c *Client) UpsertRecords(ctx context.Context, zoneID uuid.UUID, records []RecordConfig) (*[]Record, error) {
+	for _, cfg := range records {
+		if err := cfg.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	existing, err := c.ListRecords(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []Record{}
+	for _, cfg := range records {
+		i := indexOf(*existing, cfg)
+		if i < 0 {
+			r, err := c.CreateRecord(ctx, zoneID, cfg)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, *r)
+			// guard against duplicated records
+			*existing = append(*existing, *r)
+			continue
+		}
+		r := (*existing)[i]
+		if cfg.TTL == 0 {
+			cfg.TTL = r.TTL
+		}
+		if !r.Config().equal(cfg) {
+			updated, err := c.UpdateRecord(ctx, zoneID, r.UUID, cfg)
+			if err != nil {
+				return nil, err
+			}
+			r = *updated
+			(*existing)[i] = r
+		}
+		result = append(result, r)
+	}
+	return &result, nil
+}
+
+// indexOf returns index of record cfg describes, -1 when there's none.
+func indexOf(records []Record, cfg RecordConfig) int {
+	for i, r := range records {
+		if cfg.sameRecord(r) {
+			return i
+		}
+	}
+	return -1
+}
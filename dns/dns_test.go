@@ -0,0 +1,242 @@
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	zoneID   uuid.UUID = uuid.MustParse("3f1c2b6e-8a4d-4e2f-9b7a-5c6d7e8f9a0b")
+	recordID uuid.UUID = uuid.MustParse("9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d")
+)
+
+func TestListZones(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/dns/zones", r.RequestURI)
+		w.Write([]byte(`[{"name":"example.com","name_servers":["ns1.example.net"]}]`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	zones, err := c.ListZones(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com", (*zones)[0].Name)
+}
+
+func TestGetZone(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/dns/zones/%s", zoneID), r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	c.GetZone(context.Background(), zoneID)
+}
+
+func TestFindZone(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name":"example.com"},{"name":"dev.example.com"},{"name":"myexample.com"}]`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	z, err := c.FindZone(context.Background(), "www.dev.example.com.")
+	assert.NoError(t, err)
+	assert.Equal(t, "dev.example.com", z.Name)
+
+	z, err = c.FindZone(context.Background(), "Example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com", z.Name)
+
+	_, err = c.FindZone(context.Background(), "example.org")
+	assert.ErrorIs(t, err, api.ErrNotFound)
+}
+
+func TestCreateZone(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/dns/zones", r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, "example.com", data["name"])
+		w.Write([]byte(`{"name":"example.com"}`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	_, err := c.CreateZone(context.Background(), "localhost")
+	assert.EqualError(t, err, `Name with value of "localhost" is invalid, must be a domain name`)
+
+	z, err := c.CreateZone(context.Background(), "example.com.")
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com", z.Name)
+}
+
+func TestDeleteZone(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/dns/zones/%s", zoneID), r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	assert.NoError(t, c.DeleteZone(context.Background(), zoneID))
+}
+
+func TestListRecords(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/dns/zones/%s/records", zoneID), r.RequestURI)
+		w.Write([]byte(`[{"type":"MX","name":"@","content":"mail.example.com","ttl":3600,"priority":10}]`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	records, err := c.ListRecords(context.Background(), zoneID)
+	assert.NoError(t, err)
+	assert.Equal(t, RecordConfig{Type: TypeMX, Name: Apex, Content: "mail.example.com", TTL: 3600, Priority: 10}, (*records)[0].Config())
+}
+
+func TestCreateRecord(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/dns/zones/%s/records", zoneID), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, map[string]interface{}{"type": "MX", "name": "@", "content": "mail.example.com", "priority": float64(0)}, data)
+		fmt.Fprintf(w, `{"uuid":"%s"}`, recordID)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	_, err := c.CreateRecord(context.Background(), zoneID, RecordConfig{Type: TypeA, Name: "www", Content: "::1"})
+	assert.Error(t, err)
+
+	// priority 0 is sent, type is upper-cased
+	rec, err := c.CreateRecord(context.Background(), zoneID, RecordConfig{Type: "mx", Name: Apex, Content: "mail.example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, recordID, rec.UUID)
+}
+
+func TestUpdateRecord(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/dns/zones/%s/records/%s", zoneID, recordID), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, float64(300), data["ttl"])
+		w.Write([]byte(`{"ttl":300}`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	rec, err := c.UpdateRecord(context.Background(), zoneID, recordID, RecordConfig{Type: TypeCNAME, Name: "www", Content: "example.com", TTL: 300})
+	assert.NoError(t, err)
+	assert.Equal(t, 300, rec.TTL)
+}
+
+func TestDeleteRecord(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/dns/zones/%s/records/%s", zoneID, recordID), r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	assert.NoError(t, c.DeleteRecord(context.Background(), zoneID, recordID))
+}
+
+func TestUpsertRecords(t *testing.T) {
+	txtID, cnameID := uuid.New(), uuid.New()
+	var created, updated []string
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, `[
+				{"uuid":"%s","type":"TXT","name":"_acme-challenge","content":"token-1","ttl":60},
+				{"uuid":"%s","type":"CNAME","name":"www","content":"old.example.com","ttl":3600},
+				{"uuid":"%s","type":"A","name":"@","content":"1.2.3.4","ttl":3600}
+			]`, txtID, cnameID, uuid.New())
+		case "POST":
+			var cfg RecordConfig
+			_ = json.NewDecoder(r.Body).Decode(&cfg)
+			created = append(created, cfg.Content)
+			json.NewEncoder(w).Encode(Record{UUID: uuid.New(), Type: cfg.Type, Name: cfg.Name, Content: cfg.Content, TTL: cfg.TTL})
+		case "PUT":
+			var cfg RecordConfig
+			_ = json.NewDecoder(r.Body).Decode(&cfg)
+			updated = append(updated, r.URL.Path)
+			// TTL of existing record is kept when not given
+			assert.Equal(t, 3600, cfg.TTL)
+			json.NewEncoder(w).Encode(Record{UUID: cnameID, Type: cfg.Type, Name: cfg.Name, Content: cfg.Content, TTL: cfg.TTL})
+		}
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	records, err := c.UpsertRecords(context.Background(), zoneID, []RecordConfig{
+		// exists, unchanged
+		{Type: TypeTXT, Name: "_acme-challenge", Content: "token-1", TTL: 60},
+		// same name, new value
+		{Type: TypeTXT, Name: "_acme-challenge", Content: "token-2", TTL: 60},
+		{Type: TypeTXT, Name: "_acme-challenge", Content: "token-2", TTL: 60},
+		// CNAME is replaced
+		{Type: TypeCNAME, Name: "www", Content: "new.example.com"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, *records, 4)
+	assert.Equal(t, txtID, (*records)[0].UUID)
+	assert.Equal(t, (*records)[1].UUID, (*records)[2].UUID)
+	assert.Equal(t, "new.example.com", (*records)[3].Content)
+	assert.Equal(t, []string{"token-2"}, created)
+	assert.Equal(t, []string{fmt.Sprintf("/v1/dns/zones/%s/records/%s", zoneID, cnameID)}, updated)
+}
+
+func TestUpsertRecords_CaseInsensitive(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		// record is unchanged, nothing is created or updated
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprintf(w, `[{"uuid":"%s","type":"txt","name":"_ACME-Challenge","content":"token-1","ttl":60}]`, uuid.New())
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	records, err := c.UpsertRecords(context.Background(), zoneID, []RecordConfig{
+		{Type: TypeTXT, Name: "_acme-challenge", Content: "token-1", TTL: 60},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, *records, 1)
+}
+
+func TestRecordConfig_Validate(t *testing.T) {
+	assert.NoError(t, RecordConfig{Type: TypeAAAA, Name: "www", Content: "2001:db8::1"}.Validate())
+	assert.NoError(t, RecordConfig{Type: TypeMX, Name: Apex, Content: "mail.example.com", Priority: 10, TTL: 300}.Validate())
+	assert.NoError(t, RecordConfig{Type: "mx", Name: Apex, Content: "mail.example.com", Priority: 10}.Validate())
+	assert.EqualError(t, RecordConfig{Type: "aaaa", Name: "www", Content: "1.2.3.4"}.Validate(), "Content with value of 1.2.3.4 is invalid, must be IPv6 address")
+
+	assert.EqualError(t, RecordConfig{Type: TypeA, Name: "www.example.com.", Content: "1.2.3.4"}.Validate(), `Name with value of "www.example.com." is invalid, must be relative to the zone or @`)
+	assert.EqualError(t, RecordConfig{Type: TypeTXT, Name: "www"}.Validate(), `Content with value of "" is invalid`)
+	assert.EqualError(t, RecordConfig{Type: TypeTXT, Name: "www", Content: "x", TTL: 30}.Validate(), "TTL with value of 30 is invalid, must be at least 60")
+	assert.EqualError(t, RecordConfig{Type: TypeA, Name: "www", Content: "1.2.3.4", Priority: 1}.Validate(), "Priority with value of 1 is invalid, only MX record has priority")
+	assert.EqualError(t, RecordConfig{Type: TypeAAAA, Name: "www", Content: "1.2.3.4"}.Validate(), "Content with value of 1.2.3.4 is invalid, must be IPv6 address")
+	assert.EqualError(t, RecordConfig{Type: "SRV", Name: "www", Content: "x"}.Validate(), `Type with value of "SRV" is invalid, must be one of A, AAAA, CNAME, MX, TXT`)
+}
+
+func TestRelativeName(t *testing.T) {
+	assert.Equal(t, "_acme-challenge.www", RelativeName("_acme-challenge.www.example.com.", "example.com"))
+	assert.Equal(t, Apex, RelativeName("Example.com.", "example.com."))
+	assert.Equal(t, "www.example.org", RelativeName("www.example.org.", "example.com"))
+	assert.Equal(t, "myexample.com", RelativeName("myexample.com", "example.com"))
+}
@@ -0,0 +1,142 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+type Client struct {
+	API *api.API
+}
+
+// Record types that can be managed
+const (
+	TypeA     = "A"
+	TypeAAAA  = "AAAA"
+	TypeCNAME = "CNAME"
+	TypeMX    = "MX"
+	TypeTXT   = "TXT"
+)
+
+// Apex is the name of records at the zone apex (the domain itself)
+const Apex = "@"
+
+// MinTTL is the lowest TTL (in seconds) accepted by the API, zero TTL means the zone default
+const MinTTL = 60
+
+// Zone is a domain which records are served by the provider's name servers
+type Zone struct {
+	UUID        uuid.UUID `json:"uuid"`
+	Name        string    `json:"name"`
+	NameServers []string  `json:"name_servers"`
+	CreatedAt   string    `json:"created_at"`
+	UpdatedAt   string    `json:"updated_at"`
+}
+
+// Record is a DNS record of zone, Name is relative to the zone (`Apex` for the zone itself).
+// Priority is used by MX records only.
+type Record struct {
+	UUID      uuid.UUID `json:"uuid"`
+	ZoneUUID  uuid.UUID `json:"zone_uuid"`
+	Type      string    `json:"type"`
+	Name      string    `json:"name"`
+	Content   string    `json:"content"`
+	TTL       int       `json:"ttl"`
+	Priority  int       `json:"priority"`
+	CreatedAt string    `json:"created_at"`
+	UpdatedAt string    `json:"updated_at"`
+}
+
+// Config returns parameters the record was created with.
+func (r Record) Config() RecordConfig {
+	return RecordConfig{Type: r.Type, Name: r.Name, Content: r.Content, TTL: r.TTL, Priority: r.Priority}
+}
+
+// RecordConfig holds parameters to create or update a record, see `Record`.
+// Priority is always sent as 0 is a valid MX priority. Type is case-insensitive, it's sent upper-cased.
+type RecordConfig struct {
+	Type     string `json:"type"`
+	Name     string `json:"name"`
+	Content  string `json:"content"`
+	TTL      int    `json:"ttl,omitempty"`
+	Priority int    `json:"priority"`
+}
+
+// Validate checks fields that are required by the API, it's called by `Client.CreateRecord()` before sending the request.
+func (cfg RecordConfig) Validate() error {
+	cfg.Type = strings.ToUpper(cfg.Type)
+	if cfg.Name == "" || strings.HasSuffix(cfg.Name, ".") {
+		return fmt.Errorf("Name with value of %q is invalid, must be relative to the zone or %s", cfg.Name, Apex)
+	}
+	if cfg.Content == "" {
+		return fmt.Errorf("Content with value of %q is invalid", cfg.Content)
+	}
+	if cfg.TTL != 0 && cfg.TTL < MinTTL {
+		return fmt.Errorf("TTL with value of %v is invalid, must be at least %d", cfg.TTL, MinTTL)
+	}
+	if cfg.Priority != 0 && cfg.Type != TypeMX {
+		return fmt.Errorf("Priority with value of %v is invalid, only %s record has priority", cfg.Priority, TypeMX)
+	}
+	switch cfg.Type {
+	case TypeA:
+		if ip := net.ParseIP(cfg.Content); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("Content with value of %v is invalid, must be IPv4 address", cfg.Content)
+		}
+	case TypeAAAA:
+		if ip := net.ParseIP(cfg.Content); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("Content with value of %v is invalid, must be IPv6 address", cfg.Content)
+		}
+	case TypeMX:
+		if cfg.Priority < 0 || cfg.Priority > 65535 {
+			return fmt.Errorf("Priority with value of %v is invalid, must be between 0 and 65535", cfg.Priority)
+		}
+	case TypeCNAME, TypeTXT:
+	default:
+		return fmt.Errorf("Type with value of %q is invalid, must be one of %s, %s, %s, %s, %s",
+			cfg.Type, TypeA, TypeAAAA, TypeCNAME, TypeMX, TypeTXT)
+	}
+	return nil
+}
+
+// sameRecord tells whether r is the record cfg describes, see `Client.UpsertRecords()`.
+// CNAME is matched by name only as there can be only one, other types by content too.
+func (cfg RecordConfig) sameRecord(r Record) bool {
+	if !strings.EqualFold(cfg.Type, r.Type) || !strings.EqualFold(cfg.Name, r.Name) {
+		return false
+	}
+	return strings.EqualFold(cfg.Type, TypeCNAME) || cfg.Content == r.Content
+}
+
+// equal tells whether cfg and other describe the same record with the same settings,
+// Type and Name are case-insensitive.
+func (cfg RecordConfig) equal(other RecordConfig) bool {
+	return strings.EqualFold(cfg.Type, other.Type) && strings.EqualFold(cfg.Name, other.Name) &&
+		cfg.Content == other.Content && cfg.TTL == other.TTL && cfg.Priority == other.Priority
+}
+
+// RelativeName returns fqdn relative to zone as used in `Record.Name`, `Apex` for zone itself.
+// Both may end with dot, fqdn outside of zone is returned unchanged (without the dot).
+//
+//	RelativeName("_acme-challenge.www.example.com.", "example.com") // "_acme-challenge.www"
+func RelativeName(fqdn, zone string) string {
+	fqdn = strings.TrimSuffix(fqdn, ".")
+	zone = strings.TrimSuffix(zone, ".")
+	switch {
+	case strings.EqualFold(fqdn, zone):
+		return Apex
+	case inZone(fqdn, zone):
+		return fqdn[:len(fqdn)-len(zone)-1]
+	}
+	return fqdn
+}
+
+// inZone tells whether fqdn is zone or its subdomain, both may end with dot.
+func inZone(fqdn, zone string) bool {
+	fqdn = strings.ToLower(strings.TrimSuffix(fqdn, "."))
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	return fqdn == zone || strings.HasSuffix(fqdn, "."+zone)
+}
@@ -0,0 +1,62 @@
+// Package events fetches the account activity (audit) log.
+package events
+
+import (
+	"context"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/query"
+)
+
+func NewClient(client *api.API) *Client {
+	return &Client{
+		API: client,
+	}
+}
+
+// ListEvents https://api.warren.io/#list-events
+func (c *Client) ListEvents(ctx context.Context, opts ListEventsOptions) (*[]Event, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	q, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/user-resource/events",
+		Query:  q,
+	}
+	var events []Event
+	if err := c.API.FormRequest(ctx, rc).Into(&events); err != nil {
+		return nil, err
+	}
+	return &events, nil
+}
+
+// ListEventsIterator returns iterator over `ListEvents()` results, fetching limit items per page.
+// Use it to ingest large time ranges, e.g. into SIEM.
+func (c *Client) ListEventsIterator(opts ListEventsOptions, limit int) *api.Iterator[Event] {
+	return api.NewIterator(func(ctx context.Context, page, limit int) ([]Event, error) {
+		if err := opts.Validate(); err != nil {
+			return nil, err
+		}
+		q, err := query.Values(opts)
+		if err != nil {
+			return nil, err
+		}
+		rc := api.RequestConfig{
+			Method: "GET",
+			Path:   "/v1/user-resource/events",
+			Query:  q,
+			Page:   page,
+			Limit:  limit,
+		}
+		var events []Event
+		if err := c.API.FormRequest(ctx, rc).Into(&events); err != nil {
+			return nil, err
+		}
+		return events, nil
+	}, limit)
+}
@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	vmID uuid.UUID = uuid.MustParse("8d2d4e3a-5c86-4a4e-9d5b-0b3c4a0f2c11")
+	from           = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to             = time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+)
+
+func TestListEvents(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/user-resource/events", r.URL.Path)
+
+		q := r.URL.Query()
+		assert.Equal(t, "2024-01-01T00:00:00Z", q.Get("start_time"))
+		assert.Equal(t, "2024-01-02T00:00:00Z", q.Get("end_time"))
+		assert.Equal(t, ResourceTypeVM, q.Get("resource_type"))
+		assert.Equal(t, vmID.String(), q.Get("resource_uuid"))
+		assert.Equal(t, ActionDelete, q.Get("action"))
+		w.Write([]byte(fmt.Sprintf(`[{"id":1,"action":"delete","resource_type":"vm","resource_uuid":"%s","success":true,"details":{"name":"web-1"}}]`, vmID)))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	events, err := c.ListEvents(context.Background(), ListEventsOptions{
+		From:         from,
+		To:           to,
+		ResourceType: ResourceTypeVM,
+		ResourceUUID: vmID,
+		Action:       ActionDelete,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, *events, 1)
+	e := (*events)[0]
+	assert.Equal(t, vmID, e.ResourceUUID.UUID)
+	assert.True(t, e.Success)
+	assert.Equal(t, "web-1", e.Details["name"])
+}
+
+func TestListEvents_NoFilters(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/user-resource/events", r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	c.ListEvents(context.Background(), ListEventsOptions{})
+}
+
+func TestListEvents_InvalidRange(t *testing.T) {
+	c := Client{}
+	events, err := c.ListEvents(context.Background(), ListEventsOptions{From: to, To: from})
+	assert.Nil(t, events)
+	assert.EqualError(t, err, "To with value of 2024-01-01T00:00:00Z is invalid, must be after From")
+}
+
+func TestListEventsIterator(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/user-resource/events?action=login&limit=2&page=1", r.RequestURI)
+		w.Write([]byte("[{}]"))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	it := c.ListEventsIterator(ListEventsOptions{Action: ActionLogin}, 2)
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+type Client struct {
+	API *api.API
+}
+
+// Resource types events are reported for
+const (
+	ResourceTypeVM           = "vm"
+	ResourceTypeDisk         = "disk"
+	ResourceTypeFloatingIP   = "floating_ip"
+	ResourceTypeNetwork      = "network"
+	ResourceTypeLoadBalancer = "load_balancer"
+	ResourceTypeCluster      = "cluster"
+	ResourceTypeBucket       = "bucket"
+	ResourceTypeToken        = "token"
+)
+
+// Event actions
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+	ActionStart  = "start"
+	ActionStop   = "stop"
+	ActionLogin  = "login"
+)
+
+// Event is a single entry of the account activity log.
+// ResourceUUID is not set for resources identified otherwise (e.g. bucket name, floating IP address), see ResourceName.
+type Event struct {
+	ID           int                    `json:"id"`
+	Action       string                 `json:"action"`
+	ResourceType string                 `json:"resource_type"`
+	ResourceUUID uuid.NullUUID          `json:"resource_uuid"`
+	ResourceName string                 `json:"resource_name"`
+	UserID       int                    `json:"user_id"`
+	UserEmail    string                 `json:"user_email"`
+	IPAddress    string                 `json:"ip_address"`
+	Success      bool                   `json:"success"`
+	Details      map[string]interface{} `json:"details"`
+	CreatedAt    string                 `json:"created_at"`
+}
+
+// ListEventsOptions filters `Client.ListEvents()` results, zero values are ignored.
+// From is inclusive and To is exclusive.
+type ListEventsOptions struct {
+	From         time.Time `qs:"start_time,omitempty"`
+	To           time.Time `qs:"end_time,omitempty"`
+	ResourceType string    `qs:"resource_type,omitempty"`
+	ResourceUUID uuid.UUID `qs:"resource_uuid,omitempty"`
+	Action       string    `qs:"action,omitempty"`
+}
+
+// Validate checks the time range, it's called by `Client.ListEvents()` before sending the request.
+func (o ListEventsOptions) Validate() error {
+	if !o.From.IsZero() && !o.To.IsZero() && !o.To.After(o.From) {
+		return fmt.Errorf("To with value of %v is invalid, must be after From", o.To.Format(time.RFC3339))
+	}
+	return nil
+}
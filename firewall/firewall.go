@@ -0,0 +1,132 @@
+// Package firewall manages inbound traffic rules of VMs and private networks.
+package firewall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/query"
+	"github.com/google/uuid"
+)
+
+func NewClient(client *api.API, location string) *Client {
+	return &Client{
+		API:      client,
+		Location: location,
+	}
+}
+
+// ListRules https://api.warren.io/#list-firewall-rules
+func (c *Client) ListRules(ctx context.Context, scope Scope) (*[]Rule, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+	q, err := query.Values(scope)
+	if err != nil {
+		return nil, err
+	}
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/network/firewall/rules", c.Location),
+		Query:  q,
+	}
+	var rules []Rule
+	if err := c.API.JSONRequest(ctx, rc).Into(&rules); err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+// CreateRule https://api.warren.io/#create-firewall-rule
+func (c *Client) CreateRule(ctx context.Context, scope Scope, cfg RuleConfig) (*Rule, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	body := createRuleRequest{RuleConfig: cfg.normalized()}
+	if scope.VMUUID != uuid.Nil {
+		body.VMUUID = &scope.VMUUID
+	} else {
+		body.NetworkUUID = &scope.NetworkUUID
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/network/firewall/rules", c.Location),
+		JSON:   body,
+	}
+	var rule Rule
+	if err := c.API.JSONRequest(ctx, rc).Into(&rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// DeleteRule https://api.warren.io/#delete-firewall-rule
+func (c *Client) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/network/firewall/rules/%s", c.Location, id),
+	}
+	return c.API.JSONRequest(ctx, rc).Error
+}
+
+// SyncRules makes desired the only rules of scope, creating missing ones and deleting the rest.
+// Rules are matched by protocol, ports and source, Description of existing rules is not updated.
+// Missing rules are created before the rest is deleted so allowed traffic is not cut off in between.
+// Calling it again with the same rules makes no changes so it's safe to retry.
+func (c *Client) SyncRules(ctx context.Context, scope Scope, desired []RuleConfig) (*[]Rule, error) {
+	if err := scope.Validate(); err != nil {
+		return nil, err
+	}
+	for _, cfg := range desired {
+		if err := cfg.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	current, err := c.ListRules(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, cfg := range desired {
+		wanted[cfg.key()] = true
+	}
+
+	// first existing rule of each wanted key is kept, duplicates are deleted with the rest
+	have := make(map[string]bool, len(desired))
+	keep := make(map[uuid.UUID]bool, len(*current))
+	rules := []Rule{}
+	for _, r := range *current {
+		if k := r.Config().key(); wanted[k] && !have[k] {
+			have[k] = true
+			keep[r.UUID] = true
+			rules = append(rules, r)
+		}
+	}
+	for _, cfg := range desired {
+		k := cfg.key()
+		if have[k] {
+			continue
+		}
+		r, err := c.CreateRule(ctx, scope, cfg)
+		if err != nil {
+			return nil, err
+		}
+		have[k] = true
+		rules = append(rules, *r)
+	}
+	for _, r := range *current {
+		if keep[r.UUID] {
+			continue
+		}
+		if err := c.DeleteRule(ctx, r.UUID); err != nil && !errors.Is(err, api.ErrNotFound) {
+			return nil, err
+		}
+	}
+	return &rules, nil
+}
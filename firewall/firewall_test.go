@@ -0,0 +1,156 @@
+package firewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	loc    string    = "jkt01"
+	vmID   uuid.UUID = uuid.MustParse("4e5eadd3-8b11-4c34-812a-2cf97120b628")
+	ruleID uuid.UUID = uuid.MustParse("7c9e6679-7425-40de-944b-e07fc1f90ae7")
+)
+
+func TestScope_Validate(t *testing.T) {
+	assert.NoError(t, Scope{VMUUID: vmID}.Validate())
+	assert.NoError(t, Scope{NetworkUUID: vmID}.Validate())
+	assert.Error(t, Scope{}.Validate())
+	assert.Error(t, Scope{VMUUID: vmID, NetworkUUID: vmID}.Validate())
+}
+
+func TestRuleConfig_Validate(t *testing.T) {
+	assert.NoError(t, RuleConfig{Protocol: ProtocolTCP, PortFrom: 22}.Validate())
+	assert.NoError(t, RuleConfig{Protocol: "UDP", PortFrom: 60000, PortTo: 61000, SourceCIDR: "10.0.0.0/8"}.Validate())
+	assert.NoError(t, RuleConfig{Protocol: ProtocolICMP}.Validate())
+	assert.NoError(t, RuleConfig{Protocol: ProtocolAny, SourceCIDR: "192.168.1.10/32"}.Validate())
+
+	assert.EqualError(t, RuleConfig{Protocol: "sctp"}.Validate(), `Protocol with value of "sctp" is invalid, must be one of tcp, udp, icmp, any`)
+	assert.EqualError(t, RuleConfig{Protocol: ProtocolICMP, PortFrom: 8}.Validate(), "PortFrom with value of 8 is invalid, must be 0 for icmp")
+	assert.EqualError(t, RuleConfig{Protocol: ProtocolTCP, PortFrom: 70000}.Validate(), "PortFrom with value of 70000 is invalid, must be between 0 and 65535")
+	assert.EqualError(t, RuleConfig{Protocol: ProtocolTCP, PortFrom: 443, PortTo: 80}.Validate(), "PortTo with value of 80 is invalid, must be between 443 and 65535")
+	assert.EqualError(t, RuleConfig{Protocol: ProtocolTCP, SourceCIDR: "10.0.0.1"}.Validate(), "SourceCIDR with value of 10.0.0.1 is invalid")
+}
+
+func TestRuleConfig_key(t *testing.T) {
+	a := RuleConfig{Protocol: "TCP", PortFrom: 22, SourceCIDR: "10.1.2.3/8", Description: "ssh"}
+	b := RuleConfig{Protocol: ProtocolTCP, PortFrom: 22, PortTo: 22, SourceCIDR: "10.0.0.0/8"}
+	assert.Equal(t, a.key(), b.key())
+	assert.Equal(t, "tcp:443-443:0.0.0.0/0", RuleConfig{Protocol: ProtocolTCP, PortFrom: 443}.key())
+}
+
+func TestListRules(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/firewall/rules?vm_uuid=%s", loc, vmID), r.RequestURI)
+		fmt.Fprintf(w, `[{"uuid":"%s","vm_uuid":"%s","protocol":"tcp","port_from":22,"port_to":22,"source_cidr":"0.0.0.0/0"}]`, ruleID, vmID)
+	})
+	defer s.Close()
+
+	fw := Client{API: a, Location: loc}
+	_, err := fw.ListRules(context.Background(), Scope{})
+	assert.Error(t, err)
+
+	rules, err := fw.ListRules(context.Background(), Scope{VMUUID: vmID})
+	assert.NoError(t, err)
+	assert.Len(t, *rules, 1)
+	assert.Equal(t, vmID, (*rules)[0].VMUUID.UUID)
+	assert.Equal(t, RuleConfig{Protocol: ProtocolTCP, PortFrom: 22, PortTo: 22, SourceCIDR: AnySource}, (*rules)[0].Config())
+}
+
+func TestCreateRule(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/firewall/rules", loc), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, map[string]interface{}{
+			"network_uuid": vmID.String(),
+			"protocol":     "tcp",
+			"port_from":    float64(8000),
+			"port_to":      float64(8000),
+			"source_cidr":  "0.0.0.0/0",
+			"description":  "app",
+		}, data)
+		fmt.Fprintf(w, `{"uuid":"%s"}`, ruleID)
+	})
+	defer s.Close()
+
+	fw := Client{API: a, Location: loc}
+	_, err := fw.CreateRule(context.Background(), Scope{NetworkUUID: vmID}, RuleConfig{Protocol: "http"})
+	assert.Error(t, err)
+
+	rule, err := fw.CreateRule(context.Background(), Scope{NetworkUUID: vmID}, RuleConfig{Protocol: "TCP", PortFrom: 8000, Description: "app"})
+	assert.NoError(t, err)
+	assert.Equal(t, ruleID, rule.UUID)
+}
+
+func TestDeleteRule(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/firewall/rules/%s", loc, ruleID), r.RequestURI)
+	})
+	defer s.Close()
+
+	fw := Client{API: a, Location: loc}
+	assert.NoError(t, fw.DeleteRule(context.Background(), ruleID))
+}
+
+func TestSyncRules(t *testing.T) {
+	keep, dup, stale := uuid.New(), uuid.New(), uuid.New()
+	var deleted, created []string
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, `[
+				{"uuid":"%s","protocol":"tcp","port_from":22,"port_to":22,"source_cidr":"10.0.0.0/8"},
+				{"uuid":"%s","protocol":"tcp","port_from":22,"port_to":22,"source_cidr":"10.0.0.0/8"},
+				{"uuid":"%s","protocol":"tcp","port_from":3306,"port_to":3306,"source_cidr":"0.0.0.0/0"}
+			]`, keep, dup, stale)
+		case "POST":
+			// created before anything is deleted
+			assert.Empty(t, deleted)
+			var data map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&data)
+			created = append(created, fmt.Sprintf("%s:%v", data["protocol"], data["port_from"]))
+			fmt.Fprintf(w, `{"uuid":"%s"}`, uuid.New())
+		case "DELETE":
+			deleted = append(deleted, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer s.Close()
+
+	fw := Client{API: a, Location: loc}
+	rules, err := fw.SyncRules(context.Background(), Scope{VMUUID: vmID}, []RuleConfig{
+		{Protocol: ProtocolTCP, PortFrom: 22, SourceCIDR: "10.0.0.0/8"},
+		{Protocol: ProtocolTCP, PortFrom: 443},
+		{Protocol: ProtocolTCP, PortFrom: 443, PortTo: 443},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, *rules, 2)
+	assert.Equal(t, keep, (*rules)[0].UUID)
+	assert.Equal(t, []string{"tcp:443"}, created)
+	assert.Equal(t, []string{
+		fmt.Sprintf("/v1/%s/network/firewall/rules/%s", loc, dup),
+		fmt.Sprintf("/v1/%s/network/firewall/rules/%s", loc, stale),
+	}, deleted)
+}
+
+func TestSyncRules_Invalid(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request should be made")
+	})
+	defer s.Close()
+
+	fw := Client{API: a, Location: loc}
+	_, err := fw.SyncRules(context.Background(), Scope{VMUUID: vmID}, []RuleConfig{{Protocol: ProtocolTCP, PortFrom: 22}, {Protocol: "ftp"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,128 @@
+package firewall
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+type Client struct {
+	API      *api.API
+	Location string
+}
+
+// Protocols rule can match
+const (
+	ProtocolTCP  = "tcp"
+	ProtocolUDP  = "udp"
+	ProtocolICMP = "icmp"
+	ProtocolAny  = "any"
+)
+
+// AnySource is source CIDR matching every IPv4 address, used when RuleConfig.SourceCIDR is empty
+const AnySource = "0.0.0.0/0"
+
+// Rule allows inbound traffic to VM, or to every VM connected to private network.
+// Traffic not matching any rule is dropped once VM or network has at least one rule.
+type Rule struct {
+	UUID        uuid.UUID     `json:"uuid"`
+	VMUUID      uuid.NullUUID `json:"vm_uuid"`
+	NetworkUUID uuid.NullUUID `json:"network_uuid"`
+	Protocol    string        `json:"protocol"`
+	PortFrom    int           `json:"port_from"`
+	PortTo      int           `json:"port_to"`
+	SourceCIDR  string        `json:"source_cidr"`
+	Description string        `json:"description"`
+	CreatedAt   string        `json:"created_at"`
+}
+
+// Config returns parameters the rule was created with.
+func (r Rule) Config() RuleConfig {
+	return RuleConfig{
+		Protocol:    r.Protocol,
+		PortFrom:    r.PortFrom,
+		PortTo:      r.PortTo,
+		SourceCIDR:  r.SourceCIDR,
+		Description: r.Description,
+	}
+}
+
+// Scope is VM or private network rules belong to, exactly one of them must be set.
+type Scope struct {
+	VMUUID      uuid.UUID `qs:"vm_uuid,omitempty"`
+	NetworkUUID uuid.UUID `qs:"network_uuid,omitempty"`
+}
+
+// Validate checks that exactly one of VMUUID and NetworkUUID is set.
+func (s Scope) Validate() error {
+	if (s.VMUUID == uuid.Nil) == (s.NetworkUUID == uuid.Nil) {
+		return fmt.Errorf("Scope with value of %+v is invalid, exactly one of VMUUID and NetworkUUID must be set", s)
+	}
+	return nil
+}
+
+// RuleConfig holds parameters to create a new rule. PortFrom and PortTo are inclusive, PortTo defaults to PortFrom
+// and both zero means all ports, ICMP rules have no ports. Empty SourceCIDR means any source, see `AnySource`.
+type RuleConfig struct {
+	Protocol    string `json:"protocol"`
+	PortFrom    int    `json:"port_from,omitempty"`
+	PortTo      int    `json:"port_to,omitempty"`
+	SourceCIDR  string `json:"source_cidr"`
+	Description string `json:"description,omitempty"`
+}
+
+// Validate checks fields that are required by the API, it's called by `Client.CreateRule()` before sending the request.
+func (cfg RuleConfig) Validate() error {
+	cfg = cfg.normalized()
+	switch cfg.Protocol {
+	case ProtocolTCP, ProtocolUDP, ProtocolAny:
+	case ProtocolICMP:
+		if cfg.PortFrom != 0 {
+			return fmt.Errorf("PortFrom with value of %v is invalid, must be 0 for %s", cfg.PortFrom, cfg.Protocol)
+		}
+	default:
+		return fmt.Errorf("Protocol with value of %q is invalid, must be one of %s, %s, %s, %s",
+			cfg.Protocol, ProtocolTCP, ProtocolUDP, ProtocolICMP, ProtocolAny)
+	}
+	if cfg.PortFrom < 0 || cfg.PortFrom > 65535 {
+		return fmt.Errorf("PortFrom with value of %v is invalid, must be between 0 and 65535", cfg.PortFrom)
+	}
+	if cfg.PortTo < cfg.PortFrom || cfg.PortTo > 65535 {
+		return fmt.Errorf("PortTo with value of %v is invalid, must be between %d and 65535", cfg.PortTo, cfg.PortFrom)
+	}
+	if _, _, err := net.ParseCIDR(cfg.SourceCIDR); err != nil {
+		return fmt.Errorf("SourceCIDR with value of %v is invalid", cfg.SourceCIDR)
+	}
+	return nil
+}
+
+// normalized returns cfg with defaults applied and values in canonical form, so equal rules compare equal.
+func (cfg RuleConfig) normalized() RuleConfig {
+	cfg.Protocol = strings.ToLower(cfg.Protocol)
+	if cfg.PortTo == 0 {
+		cfg.PortTo = cfg.PortFrom
+	}
+	if cfg.SourceCIDR == "" {
+		cfg.SourceCIDR = AnySource
+	}
+	if _, n, err := net.ParseCIDR(cfg.SourceCIDR); err == nil {
+		cfg.SourceCIDR = n.String()
+	}
+	return cfg
+}
+
+// key identifies what traffic rule matches, Description is not part of it.
+func (cfg RuleConfig) key() string {
+	cfg = cfg.normalized()
+	return fmt.Sprintf("%s:%d-%d:%s", cfg.Protocol, cfg.PortFrom, cfg.PortTo, cfg.SourceCIDR)
+}
+
+// createRuleRequest is the body of create rule request
+type createRuleRequest struct {
+	RuleConfig
+	VMUUID      *uuid.UUID `json:"vm_uuid,omitempty"`
+	NetworkUUID *uuid.UUID `json:"network_uuid,omitempty"`
+}
@@ -0,0 +1,238 @@
+// Package format renders API resources (VM, Disk, FloatingIP, ...) as JSON, YAML or aligned table,
+// for CLIs and ops scripts.
+package format
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Format is an output format
+type Format string
+
+// Supported output formats
+const (
+	JSON  Format = "json"
+	YAML  Format = "yaml"
+	Table Format = "table"
+)
+
+// Parse returns Format of given name, e.g. value of `--output` flag.
+func Parse(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case JSON, YAML, Table:
+		return f, nil
+	}
+	return "", fmt.Errorf("Format with value of %q is invalid, must be one of %s, %s, %s", s, JSON, YAML, Table)
+}
+
+// Write renders v in given format, columns are only used by Table (see `WriteTable()`).
+func Write(w io.Writer, f Format, v interface{}, columns ...string) error {
+	switch f {
+	case JSON:
+		return WriteJSON(w, v)
+	case YAML:
+		return WriteYAML(w, v)
+	case Table:
+		return WriteTable(w, v, columns...)
+	}
+	return fmt.Errorf("Format with value of %q is invalid, must be one of %s, %s, %s", f, JSON, YAML, Table)
+}
+
+// WriteJSON renders v as indented JSON.
+func WriteJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// WriteYAML renders v as YAML using the same field names and order as its JSON encoding.
+func WriteYAML(w io.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	// JSON is valid YAML, decoding it into a node keeps the key order
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return err
+	}
+	resetStyle(&node)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// resetStyle switches nodes decoded from JSON to block style.
+func resetStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetStyle(c)
+	}
+}
+
+// WriteTable renders struct, or slice of structs (pointers are followed), as aligned table.
+// Columns are JSON field names to show in that order, empty means all fields that fit in a cell
+// (nested structs, slices and maps are skipped). Headers are field names in upper case, e.g. SIZE_GB.
+func WriteTable(w io.Writer, v interface{}, columns ...string) error {
+	rv := indirect(reflect.ValueOf(v))
+	var items []reflect.Value
+	var t reflect.Type
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		t = rv.Type().Elem()
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		for i := 0; i < rv.Len(); i++ {
+			items = append(items, indirect(rv.Index(i)))
+		}
+	case reflect.Struct:
+		t = rv.Type()
+		items = append(items, rv)
+	default:
+		return fmt.Errorf("Value with type of %T is invalid, must be struct or slice of structs", v)
+	}
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("Value with type of %T is invalid, must be struct or slice of structs", v)
+	}
+
+	fields, err := tableFields(t, columns)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	headers := make([]string, len(fields))
+	for i, f := range fields {
+		headers[i] = strings.ToUpper(f.name)
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, item := range items {
+		cells := make([]string, len(fields))
+		if item.IsValid() {
+			for i, f := range fields {
+				cells[i] = cell(item.Field(f.index))
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+type field struct {
+	name  string
+	index int
+}
+
+// tableFields returns fields of struct type t matching columns, or all cell-able fields.
+func tableFields(t reflect.Type, columns []string) ([]field, error) {
+	var all []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := strings.Split(sf.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if len(columns) == 0 && !isScalar(sf.Type) {
+			continue
+		}
+		all = append(all, field{name: name, index: i})
+	}
+	if len(columns) == 0 {
+		return all, nil
+	}
+
+	fields := make([]field, 0, len(columns))
+	for _, c := range columns {
+		found := false
+		for _, f := range all {
+			if f.name == c {
+				fields = append(fields, f)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("Column with value of %q is invalid, %s has no such field", c, t.Name())
+		}
+	}
+	return fields, nil
+}
+
+var (
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	marshalType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// isScalar reports whether values of t fit in a single table cell.
+func isScalar(t reflect.Type) bool {
+	if t.Implements(stringerType) || t.Implements(marshalType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return isScalar(t.Elem())
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map, reflect.Interface, reflect.Func, reflect.Chan:
+		return false
+	}
+	return true
+}
+
+// cell formats a field value, nil and JSON null are rendered empty.
+func cell(v reflect.Value) string {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		return cell(v.Elem())
+	}
+	i := v.Interface()
+	switch x := i.(type) {
+	case fmt.Stringer:
+		return x.String()
+	case json.Marshaler:
+		b, err := x.MarshalJSON()
+		if err != nil || string(b) == "null" {
+			return ""
+		}
+		var s string
+		if json.Unmarshal(b, &s) == nil {
+			return s
+		}
+		return string(b)
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Struct:
+		b, _ := json.Marshal(i)
+		return string(b)
+	}
+	return fmt.Sprint(i)
+}
+
+// indirect follows pointers, nil pointer results in invalid Value.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
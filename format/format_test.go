@@ -0,0 +1,79 @@
+package format
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/ekaputra07/warren-go/ip"
+	"github.com/ekaputra07/warren-go/vm"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	diskID uuid.UUID = uuid.MustParse("4e5eadd3-8b11-4c34-812a-2cf97120b628")
+	vmID   uuid.UUID = uuid.MustParse("8d2d4e3a-5c86-4a4e-9d5b-0b3c4a0f2c11")
+)
+
+func TestParse(t *testing.T) {
+	f, err := Parse("YAML")
+	assert.NoError(t, err)
+	assert.Equal(t, YAML, f)
+
+	_, err = Parse("xml")
+	assert.EqualError(t, err, `Format with value of "xml" is invalid, must be one of json, yaml, table`)
+}
+
+func TestWriteJSON(t *testing.T) {
+	var b bytes.Buffer
+	assert.NoError(t, Write(&b, JSON, blockstorage.Disk{UUID: diskID, SizeGB: 20}))
+	assert.Contains(t, b.String(), `"size_gb": 20`)
+}
+
+func TestWriteYAML(t *testing.T) {
+	var b bytes.Buffer
+	v := vm.VM{UUID: vmID, Name: "web-1", Metadata: map[string]string{"env": "prod"}}
+	assert.NoError(t, Write(&b, YAML, v))
+	out := b.String()
+	assert.Contains(t, out, "uuid: "+vmID.String()+"\n")
+	assert.Contains(t, out, "name: web-1\n")
+	assert.Contains(t, out, "metadata:\n  env: prod\n")
+	// JSON field order is kept
+	assert.Less(t, bytes.Index(b.Bytes(), []byte("uuid:")), bytes.Index(b.Bytes(), []byte("name:")))
+}
+
+func TestWriteTable(t *testing.T) {
+	disks := &[]blockstorage.Disk{
+		{UUID: diskID, Status: "ready", SizeGB: 20, SourceImageType: blockstorage.ImageTypeEmpty},
+		{UUID: diskID, Status: "creating", SizeGB: 100},
+	}
+	var b bytes.Buffer
+	assert.NoError(t, Write(&b, Table, disks, "uuid", "status", "size_gb"))
+	assert.Equal(t, "UUID                                  STATUS    SIZE_GB\n"+
+		diskID.String()+"  ready     20\n"+
+		diskID.String()+"  creating  100\n", b.String())
+}
+
+func TestWriteTable_AllColumns(t *testing.T) {
+	info := ip.IPAddressInfo{Address: "1.2.3.4", AssignedTo: uuid.NullUUID{UUID: vmID, Valid: true}}
+	var b bytes.Buffer
+	assert.NoError(t, WriteTable(&b, &info))
+	lines := bytes.Split(b.Bytes(), []byte("\n"))
+	assert.Contains(t, string(lines[0]), "ADDRESS")
+	assert.Contains(t, string(lines[0]), "ASSIGNED_TO")
+	assert.Contains(t, string(lines[1]), "1.2.3.4")
+	assert.Contains(t, string(lines[1]), vmID.String())
+
+	// slices and nested structs are skipped
+	b.Reset()
+	assert.NoError(t, WriteTable(&b, vm.VM{}))
+	assert.NotContains(t, b.String(), "STORAGE")
+	assert.NotContains(t, b.String(), "METADATA")
+}
+
+func TestWriteTable_Invalid(t *testing.T) {
+	var b bytes.Buffer
+	assert.EqualError(t, WriteTable(&b, "disk"), "Value with type of string is invalid, must be struct or slice of structs")
+	assert.EqualError(t, WriteTable(&b, blockstorage.Disk{}, "hostname"), `Column with value of "hostname" is invalid, Disk has no such field`)
+}
@@ -0,0 +1,87 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+func NewClient(client *api.API) *Client {
+	return &Client{
+		API: client,
+	}
+}
+
+// ListImages https://api.warren.io/#list-images
+// Response is cached when API has cache enabled, see `api.WithCache()`.
+func (c *Client) ListImages(ctx context.Context) (*[]Image, error) {
+	rc := api.RequestConfig{
+		Method:    "GET",
+		Path:      "/v1/config/images",
+		Cacheable: true,
+	}
+	var images []Image
+	if err := c.API.FormRequest(ctx, rc).Into(&images); err != nil {
+		return nil, err
+	}
+	return &images, nil
+}
+
+// ListOSImages returns OS base images from `ListImages()`.
+func (c *Client) ListOSImages(ctx context.Context) (*[]Image, error) {
+	return c.listByType(ctx, TypeOSBase)
+}
+
+// ListDiskImages returns disk images from `ListImages()`.
+func (c *Client) ListDiskImages(ctx context.Context) (*[]Image, error) {
+	return c.listByType(ctx, TypeDisk)
+}
+
+func (c *Client) listByType(ctx context.Context, t string) (*[]Image, error) {
+	images, err := c.ListImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filtered := []Image{}
+	for _, img := range *images {
+		if img.Type == t {
+			filtered = append(filtered, img)
+		}
+	}
+	return &filtered, nil
+}
+
+// CreateImageFromDisk https://api.warren.io/#create-image
+// The resulting image is of `TypeDisk` and can be used as source of new disk (see `blockstorage.CreateDiskConfig`)
+// or VM (see `vm.CreateVMConfig`) by its ID. Stop VM using the disk first to get a consistent image.
+func (c *Client) CreateImageFromDisk(ctx context.Context, diskID uuid.UUID, name string) (*Image, error) {
+	if name == "" {
+		return nil, fmt.Errorf("Name with value of %q is invalid", name)
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   "/v1/storage/images",
+		Data: url.Values{
+			"disk_uuid": []string{diskID.String()},
+			"name":      []string{name},
+		},
+	}
+	var img Image
+	if err := c.API.FormRequest(ctx, rc).Into(&img); err != nil {
+		return nil, err
+	}
+	return &img, nil
+}
+
+// DeleteImage https://api.warren.io/#delete-image
+// Only images created with `CreateImageFromDisk()` can be deleted.
+func (c *Client) DeleteImage(ctx context.Context, id string) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/storage/images/%s", url.PathEscape(id)),
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
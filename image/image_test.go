@@ -0,0 +1,102 @@
+package image
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const catalog = `[
+	{"id":"ubuntu_20.04","name":"ubuntu","version":"20.04","type":"OS_BASE","min_disk_size_gb":20},
+	{"id":"backup-1","name":"backup","version":"","type":"DISK","min_disk_size_gb":40}
+]`
+
+func TestListImages(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/config/images", r.RequestURI)
+	})
+	defer s.Close()
+
+	i := Client{API: a}
+	i.ListImages(context.Background())
+}
+
+func TestListOSImages(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(catalog))
+	})
+	defer s.Close()
+
+	i := Client{API: a}
+	images, err := i.ListOSImages(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, *images, 1)
+	assert.Equal(t, "ubuntu_20.04", (*images)[0].ID)
+}
+
+func TestListDiskImages(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(catalog))
+	})
+	defer s.Close()
+
+	i := Client{API: a}
+	images, err := i.ListDiskImages(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, *images, 1)
+	assert.Equal(t, 40, (*images)[0].MinDiskSizeGB)
+}
+
+func TestCreateImageFromDisk(t *testing.T) {
+	diskID := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/storage/images", r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, diskID.String(), r.Form.Get("disk_uuid"))
+		assert.Equal(t, "web-template", r.Form.Get("name"))
+		w.Write([]byte(`{"id":"5f0c","name":"web-template","type":"DISK","min_disk_size_gb":20}`))
+	})
+	defer s.Close()
+
+	i := Client{API: a}
+	_, err := i.CreateImageFromDisk(context.Background(), diskID, "")
+	assert.EqualError(t, err, `Name with value of "" is invalid`)
+
+	img, err := i.CreateImageFromDisk(context.Background(), diskID, "web-template")
+	assert.NoError(t, err)
+	assert.Equal(t, "5f0c", img.ID)
+	assert.Equal(t, TypeDisk, img.Type)
+}
+
+func TestDeleteImage(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/v1/storage/images/5f0c", r.RequestURI)
+	})
+	defer s.Close()
+
+	i := Client{API: a}
+	assert.NoError(t, i.DeleteImage(context.Background(), "5f0c"))
+}
+
+func TestFind(t *testing.T) {
+	images := []Image{{ID: "a"}, {ID: "b"}}
+	assert.Equal(t, "b", Find(images, "b").ID)
+	assert.Nil(t, Find(images, "c"))
+}
+
+func TestFindOS(t *testing.T) {
+	images := []Image{
+		{ID: "a", Name: "ubuntu", Version: "20.04", Type: TypeDisk},
+		{ID: "b", Name: "ubuntu", Version: "20.04", Type: TypeOSBase},
+	}
+	assert.Equal(t, "b", FindOS(images, "ubuntu", "20.04").ID)
+	assert.Nil(t, FindOS(images, "ubuntu", "22.04"))
+}
@@ -0,0 +1,43 @@
+package image
+
+import "github.com/ekaputra07/warren-go/api"
+
+type Client struct {
+	API *api.API
+}
+
+// Image types, matching `blockstorage.SourceImageType` values
+const (
+	TypeOSBase = "OS_BASE"
+	TypeDisk   = "DISK"
+)
+
+// Image is an OS base image or disk image that can be used as source of a new disk or VM
+type Image struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	Type          string `json:"type"`
+	MinDiskSizeGB int    `json:"min_disk_size_gb"`
+}
+
+// Find returns image with given ID, nil if not found.
+func Find(images []Image, id string) *Image {
+	for i := range images {
+		if images[i].ID == id {
+			return &images[i]
+		}
+	}
+	return nil
+}
+
+// FindOS returns OS base image with given name and version, nil if not found.
+func FindOS(images []Image, name, version string) *Image {
+	for i := range images {
+		img := &images[i]
+		if img.Type == TypeOSBase && img.Name == name && img.Version == version {
+			return img
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FindImage returns the newest OS base image named name (case-insensitive) which version satisfies constraint,
+// so automation keeps working when the catalog moves on to newer versions.
+//
+// Constraint is a comma or space separated list of conditions that all must hold, each being version
+// prefixed with one of the operators `=`, `!=`, `>`, `>=`, `<` or `<=` (no operator means `=`), e.g. ">=22.04, <24".
+// Versions are compared numerically by their dot separated parts, missing parts count as zero so "22" equals "22.0".
+// Empty constraint or "*" matches any version.
+func (c *Client) FindImage(ctx context.Context, name, constraint string) (*Image, error) {
+	images, err := c.ListOSImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Match(*images, name, constraint)
+}
+
+// Match is `Client.FindImage()` over already fetched images, non OS base images are ignored.
+func Match(images []Image, name, constraint string) (*Image, error) {
+	conds, err := parseConstraint(constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	var best *Image
+	var bestVersion []int
+	for i := range images {
+		img := &images[i]
+		if img.Type != TypeOSBase || !strings.EqualFold(img.Name, name) {
+			continue
+		}
+		v, ok := parseVersion(img.Version)
+		if !ok || !conds.match(v) {
+			continue
+		}
+		if best == nil || compareVersions(v, bestVersion) > 0 {
+			best, bestVersion = img, v
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("Name and constraint with value of %v %q is invalid, no image matches", name, constraint)
+	}
+	return best, nil
+}
+
+// condition is a single comparison of constraint, e.g. ">=22.04"
+type condition struct {
+	op      string
+	version []int
+}
+
+type conditions []condition
+
+// match tells whether v satisfies all conditions.
+func (cs conditions) match(v []int) bool {
+	for _, c := range cs {
+		cmp := compareVersions(v, c.version)
+		var ok bool
+		switch c.op {
+		case "=", "==":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// operators are checked in order so the longer ones win
+var operators = []string{">=", "<=", "!=", "==", ">", "<", "="}
+
+// parseConstraint parses constraint described in `Client.FindImage()`.
+func parseConstraint(constraint string) (conditions, error) {
+	var conds conditions
+	fields := strings.FieldsFunc(constraint, func(r rune) bool { return r == ',' || r == ' ' })
+	for i := 0; i < len(fields); i++ {
+		f := fields[i]
+		if f == "*" {
+			continue
+		}
+		op := "="
+		for _, o := range operators {
+			if strings.HasPrefix(f, o) {
+				op, f = o, strings.TrimPrefix(f, o)
+				break
+			}
+		}
+		// operator separated from its version, e.g. ">= 22.04"
+		if f == "" && i+1 < len(fields) {
+			i++
+			f = fields[i]
+		}
+		v, ok := parseVersion(f)
+		if !ok {
+			return nil, fmt.Errorf("Constraint with value of %q is invalid", constraint)
+		}
+		conds = append(conds, condition{op: op, version: v})
+	}
+	return conds, nil
+}
+
+// parseVersion parses dot separated numeric parts of version, suffix that isn't part of a number
+// (e.g. "-stream" of "9-stream") is ignored. Version without leading number is not parseable.
+func parseVersion(s string) ([]int, bool) {
+	var v []int
+	for _, p := range strings.Split(strings.TrimPrefix(s, "v"), ".") {
+		end := 0
+		for end < len(p) && p[end] >= '0' && p[end] <= '9' {
+			end++
+		}
+		if end == 0 {
+			break
+		}
+		n, err := strconv.Atoi(p[:end])
+		if err != nil {
+			return nil, false
+		}
+		v = append(v, n)
+		// the rest is suffix
+		if end < len(p) {
+			break
+		}
+	}
+	return v, len(v) > 0
+}
+
+// compareVersions returns -1, 0 or 1 when a is older, same or newer than b.
+func compareVersions(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,75 @@
+package image
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+var versioned = []Image{
+	{ID: "ubuntu_18.04", Name: "ubuntu", Version: "18.04", Type: TypeOSBase},
+	{ID: "ubuntu_22.04", Name: "ubuntu", Version: "22.04", Type: TypeOSBase},
+	{ID: "ubuntu_20.04", Name: "ubuntu", Version: "20.04", Type: TypeOSBase},
+	{ID: "ubuntu_24.04", Name: "Ubuntu", Version: "24.04", Type: TypeOSBase},
+	{ID: "ubuntu-disk", Name: "ubuntu", Version: "30.04", Type: TypeDisk},
+	{ID: "centos_9", Name: "centos", Version: "9-stream", Type: TypeOSBase},
+	{ID: "centos_latest", Name: "centos", Version: "latest", Type: TypeOSBase},
+}
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		name, constraint, want string
+	}{
+		{"ubuntu", "", "ubuntu_24.04"},
+		{"ubuntu", "*", "ubuntu_24.04"},
+		{"ubuntu", ">=22.04", "ubuntu_24.04"},
+		{"ubuntu", ">=20.04, <24", "ubuntu_22.04"},
+		{"ubuntu", ">= 20.04 < 22", "ubuntu_20.04"},
+		{"ubuntu", "20.04", "ubuntu_20.04"},
+		{"ubuntu", "==18.4", "ubuntu_18.04"},
+		{"ubuntu", "<=22.04,!=22.04", "ubuntu_20.04"},
+		{"UBUNTU", ">22", "ubuntu_24.04"},
+		{"centos", ">=9", "centos_9"},
+	}
+	for _, c := range cases {
+		img, err := Match(versioned, c.name, c.constraint)
+		if assert.NoError(t, err, c.constraint) {
+			assert.Equal(t, c.want, img.ID, c.constraint)
+		}
+	}
+}
+
+func TestMatch_NoMatch(t *testing.T) {
+	img, err := Match(versioned, "ubuntu", ">24.04")
+	assert.Nil(t, img)
+	assert.EqualError(t, err, `Name and constraint with value of ubuntu ">24.04" is invalid, no image matches`)
+
+	_, err = Match(versioned, "debian", "")
+	assert.Error(t, err)
+}
+
+func TestMatch_InvalidConstraint(t *testing.T) {
+	for _, c := range []string{">=", "~22", ">=abc", "22.04 <"} {
+		_, err := Match(versioned, "ubuntu", c)
+		assert.EqualError(t, err, `Constraint with value of "`+c+`" is invalid`)
+	}
+}
+
+func TestFindImage(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/config/images", r.RequestURI)
+		w.Write([]byte(`[
+			{"id":"ubuntu_20.04","name":"ubuntu","version":"20.04","type":"OS_BASE"},
+			{"id":"ubuntu_22.04","name":"ubuntu","version":"22.04","type":"OS_BASE"}
+		]`))
+	})
+	defer s.Close()
+
+	i := Client{API: a}
+	img, err := i.FindImage(context.Background(), "ubuntu", ">=20.04")
+	assert.NoError(t, err)
+	assert.Equal(t, "ubuntu_22.04", img.ID)
+}
@@ -2,8 +2,8 @@ package ip
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/ekaputra07/warren-go/api"
 	"github.com/google/uuid"
@@ -22,17 +22,30 @@ func (c *Client) ListFloatingIPs(ctx context.Context) (*[]IPAddressInfo, error)
 		Method: "GET",
 		Path:   fmt.Sprintf("/v1/%s/network/ip_addresses", c.Location),
 	}
-	res := c.API.JSONRequest(ctx, rc)
-	if res.Error != nil {
-		return nil, res.Error
-	}
 	var ips []IPAddressInfo
-	if err := json.Unmarshal(res.Body, &ips); err != nil {
+	if err := c.API.JSONRequest(ctx, rc).Into(&ips); err != nil {
 		return nil, err
 	}
 	return &ips, nil
 }
 
+// ListFloatingIPsIterator returns iterator over `ListFloatingIPs()` results, fetching limit items per page.
+func (c *Client) ListFloatingIPsIterator(limit int) *api.Iterator[IPAddressInfo] {
+	return api.NewIterator(func(ctx context.Context, page, limit int) ([]IPAddressInfo, error) {
+		rc := api.RequestConfig{
+			Method: "GET",
+			Path:   fmt.Sprintf("/v1/%s/network/ip_addresses", c.Location),
+			Page:   page,
+			Limit:  limit,
+		}
+		var ips []IPAddressInfo
+		if err := c.API.JSONRequest(ctx, rc).Into(&ips); err != nil {
+			return nil, err
+		}
+		return ips, nil
+	}, limit)
+}
+
 // CreateFloatingIP https://api.warren.io/#create-floating-ip
 func (c *Client) CreateFloatingIP(ctx context.Context, info *IPAddressInfo) error {
 	if info.BillingAccountID == 0 {
@@ -47,14 +60,7 @@ func (c *Client) CreateFloatingIP(ctx context.Context, info *IPAddressInfo) erro
 			"billing_account_id": info.BillingAccountID,
 		},
 	}
-	res := c.API.JSONRequest(ctx, rc)
-	if res.Error != nil {
-		return res.Error
-	}
-	if err := json.Unmarshal(res.Body, info); err != nil {
-		return err
-	}
-	return nil
+	return c.API.JSONRequest(ctx, rc).Into(info)
 }
 
 // GetFloatingIP https://api.warren.io/#get-floating-ip
@@ -63,12 +69,8 @@ func (c *Client) GetFloatingIP(ctx context.Context, address string) (*IPAddressI
 		Method: "GET",
 		Path:   fmt.Sprintf("/v1/%s/network/ip_addresses/%s", c.Location, address),
 	}
-	res := c.API.JSONRequest(ctx, rc)
-	if res.Error != nil {
-		return nil, res.Error
-	}
 	var ip IPAddressInfo
-	if err := json.Unmarshal(res.Body, &ip); err != nil {
+	if err := c.API.JSONRequest(ctx, rc).Into(&ip); err != nil {
 		return nil, err
 	}
 	return &ip, nil
@@ -95,6 +97,57 @@ func (c *Client) UpdateFloatingIP(ctx context.Context, info *IPAddressInfo) erro
 	return nil
 }
 
+// UpdateFloatingIPBillingAccount https://api.warren.io/#update-floating-ip
+// Unlike `UpdateFloatingIP()` it leaves the name unchanged.
+func (c *Client) UpdateFloatingIPBillingAccount(ctx context.Context, address string, billingAccountID int) error {
+	if billingAccountID <= 0 {
+		return fmt.Errorf("BillingAccountID with value of %v is invalid", billingAccountID)
+	}
+
+	rc := api.RequestConfig{
+		Method: "PATCH",
+		Path:   fmt.Sprintf("/v1/%s/network/ip_addresses/%s", c.Location, address),
+		JSON:   map[string]interface{}{"billing_account_id": billingAccountID},
+	}
+	return c.API.JSONRequest(ctx, rc).Error
+}
+
+// SetFloatingIPReverseDNS https://api.warren.io/#set-floating-ip-ptr
+// Hostname becomes PTR record of the address, it should resolve back to the address (e.g. for mail servers).
+// Empty hostname resets the record to the provider's default.
+func (c *Client) SetFloatingIPReverseDNS(ctx context.Context, address, hostname string) error {
+	hostname = strings.TrimSuffix(hostname, ".")
+	if hostname != "" && !validHostname(hostname) {
+		return fmt.Errorf("Hostname with value of %v is invalid", hostname)
+	}
+
+	rc := api.RequestConfig{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/%s/network/ip_addresses/%s/ptr", c.Location, address),
+		JSON:   map[string]interface{}{"ptr": hostname},
+	}
+	return c.API.JSONRequest(ctx, rc).Error
+}
+
+// validHostname checks that hostname is fully qualified and made of valid DNS labels.
+func validHostname(hostname string) bool {
+	labels := strings.Split(hostname, ".")
+	if len(hostname) > 253 || len(labels) < 2 {
+		return false
+	}
+	for _, l := range labels {
+		if len(l) == 0 || len(l) > 63 || l[0] == '-' || l[len(l)-1] == '-' {
+			return false
+		}
+		for _, r := range l {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // DeleteFloatingIP https://api.warren.io/#delete-floating-ip
 func (c *Client) DeleteFloatingIP(ctx context.Context, address string) error {
 	rc := api.RequestConfig{
@@ -88,6 +88,47 @@ func TestUpdateFloatingIP(t *testing.T) {
 	ip.UpdateFloatingIP(context.Background(), &info)
 }
 
+func TestUpdateFloatingIPBillingAccount(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/ip_addresses/%s", loc, address), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, map[string]interface{}{"billing_account_id": float64(456)}, data)
+	})
+	defer s.Close()
+
+	ip := Client{API: a, Location: loc}
+	assert.EqualError(t, ip.UpdateFloatingIPBillingAccount(context.Background(), address, 0), "BillingAccountID with value of 0 is invalid")
+	assert.NoError(t, ip.UpdateFloatingIPBillingAccount(context.Background(), address, 456))
+}
+
+func TestSetFloatingIPReverseDNS(t *testing.T) {
+	var ptr interface{}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/ip_addresses/%s/ptr", loc, address), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		ptr = data["ptr"]
+	})
+	defer s.Close()
+
+	ip := Client{API: a, Location: loc}
+	assert.NoError(t, ip.SetFloatingIPReverseDNS(context.Background(), address, "mail.example.com."))
+	assert.Equal(t, "mail.example.com", ptr)
+
+	// reset
+	assert.NoError(t, ip.SetFloatingIPReverseDNS(context.Background(), address, ""))
+	assert.Equal(t, "", ptr)
+
+	for _, h := range []string{"localhost", "-mail.example.com", "mail..example.com", "mail_1.example.com"} {
+		assert.EqualError(t, ip.SetFloatingIPReverseDNS(context.Background(), address, h), "Hostname with value of "+h+" is invalid")
+	}
+}
+
 func TestDeleteFloatingIP(t *testing.T) {
 	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "DELETE", r.Method)
@@ -128,3 +169,30 @@ func TestUnassignFloatingIPFromVM(t *testing.T) {
 	ip := Client{API: a, Location: loc}
 	ip.UnassignFloatingIPFromVM(context.Background(), address, vmUUID)
 }
+
+func TestListFloatingIPsIterator(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/ip_addresses?limit=2&page=1", loc), r.RequestURI)
+		w.Write([]byte("[{}]"))
+	})
+	defer s.Close()
+
+	ip := Client{API: a, Location: loc}
+	it := ip.ListFloatingIPsIterator(2)
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
+
+func TestIPAddressInfo_IsAssigned(t *testing.T) {
+	var fip FloatingIP
+	assert.NoError(t, json.Unmarshal([]byte(`{"address":"1.2.3.4","assigned_to":null}`), &fip))
+	assert.False(t, fip.IsAssigned())
+	assert.Nil(t, fip.Assignment())
+
+	body := fmt.Sprintf(`{"address":"1.2.3.4","assigned_to":"%s","assigned_to_resource_type":"virtual_machine","assigned_to_private_ip":"10.0.0.2"}`, vmUUID)
+	assert.NoError(t, json.Unmarshal([]byte(body), &fip))
+	assert.True(t, fip.IsAssigned())
+	assert.Equal(t, &IPAssignment{ResourceUUID: vmUUID, ResourceType: "virtual_machine", PrivateIP: "10.0.0.2"}, fip.Assignment())
+}
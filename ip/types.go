@@ -10,6 +10,10 @@ type Client struct {
 	Location string
 }
 
+// FloatingIP is public IP address that can be assigned to a resource
+type FloatingIP = IPAddressInfo
+
+// IPAddressInfo represents floating IP as returned by the API, see `FloatingIP`
 type IPAddressInfo struct {
 	ID                     int           `json:"id"`
 	Address                string        `json:"address"`
@@ -26,4 +30,29 @@ type IPAddressInfo struct {
 	AssignedTo             uuid.NullUUID `json:"assigned_to"`
 	AssignedToResourceType string        `json:"assigned_to_resource_type"`
 	AssignedToPrivateIP    string        `json:"assigned_to_private_ip"`
+	ReverseDNS             string        `json:"ptr"`
+}
+
+// IPAssignment is the resource floating IP is assigned to
+type IPAssignment struct {
+	ResourceUUID uuid.UUID
+	ResourceType string
+	PrivateIP    string
+}
+
+// IsAssigned tells whether floating IP is assigned to a resource.
+func (i IPAddressInfo) IsAssigned() bool {
+	return i.AssignedTo.Valid
+}
+
+// Assignment returns resource floating IP is assigned to, nil when not assigned.
+func (i IPAddressInfo) Assignment() *IPAssignment {
+	if !i.IsAssigned() {
+		return nil
+	}
+	return &IPAssignment{
+		ResourceUUID: i.AssignedTo.UUID,
+		ResourceType: i.AssignedToResourceType,
+		PrivateIP:    i.AssignedToPrivateIP,
+	}
 }
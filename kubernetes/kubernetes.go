@@ -0,0 +1,128 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+func NewClient(client *api.API, location string) *Client {
+	return &Client{
+		API:      client,
+		Location: location,
+	}
+}
+
+// ListClusters https://api.warren.io/#list-clusters
+func (c *Client) ListClusters(ctx context.Context) (*[]Cluster, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters", c.Location),
+	}
+	var clusters []Cluster
+	if err := c.API.JSONRequest(ctx, rc).Into(&clusters); err != nil {
+		return nil, err
+	}
+	return &clusters, nil
+}
+
+// ListClustersIterator returns iterator over `ListClusters()` results, fetching limit items per page.
+func (c *Client) ListClustersIterator(limit int) *api.Iterator[Cluster] {
+	return api.NewIterator(func(ctx context.Context, page, limit int) ([]Cluster, error) {
+		rc := api.RequestConfig{
+			Method: "GET",
+			Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters", c.Location),
+			Page:   page,
+			Limit:  limit,
+		}
+		var clusters []Cluster
+		if err := c.API.JSONRequest(ctx, rc).Into(&clusters); err != nil {
+			return nil, err
+		}
+		return clusters, nil
+	}, limit)
+}
+
+// CreateCluster https://api.warren.io/#create-cluster
+func (c *Client) CreateCluster(ctx context.Context, cfg CreateClusterConfig) (*Cluster, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters", c.Location),
+		JSON:   cfg,
+	}
+	var cluster Cluster
+	if err := c.API.JSONRequest(ctx, rc).Into(&cluster); err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
+
+// GetCluster https://api.warren.io/#get-cluster
+func (c *Client) GetCluster(ctx context.Context, id uuid.UUID) (*Cluster, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters/%s", c.Location, id),
+	}
+	var cluster Cluster
+	if err := c.API.JSONRequest(ctx, rc).Into(&cluster); err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
+
+// DeleteCluster https://api.warren.io/#delete-cluster
+func (c *Client) DeleteCluster(ctx context.Context, id uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters/%s", c.Location, id),
+	}
+	return c.API.JSONRequest(ctx, rc).Error
+}
+
+// ScaleNodePool https://api.warren.io/#scale-node-pool
+func (c *Client) ScaleNodePool(ctx context.Context, id, poolID uuid.UUID, nodeCount int) (*NodePool, error) {
+	if nodeCount < 1 {
+		return nil, fmt.Errorf("nodeCount with value of %v is invalid", nodeCount)
+	}
+
+	rc := api.RequestConfig{
+		Method: "PATCH",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools/%s", c.Location, id, poolID),
+		JSON:   map[string]interface{}{"node_count": nodeCount},
+	}
+	var pool NodePool
+	if err := c.API.JSONRequest(ctx, rc).Into(&pool); err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// GetKubeconfig https://api.warren.io/#get-kubeconfig
+// It returns the raw kubeconfig file content.
+func (c *Client) GetKubeconfig(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/kubeconfig", c.Location, id),
+	}
+	res := c.API.FormRequest(ctx, rc)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	return res.Body, nil
+}
+
+// WriteKubeconfig downloads kubeconfig and writes it to path, readable only by current user.
+func (c *Client) WriteKubeconfig(ctx context.Context, id uuid.UUID, path string) error {
+	b, err := c.GetKubeconfig(ctx, id)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0600)
+}
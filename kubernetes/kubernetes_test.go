@@ -0,0 +1,160 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	loc    string    = "jkt01"
+	id     uuid.UUID = uuid.MustParse("4e5eadd3-8b11-4c34-812a-2cf97120b628")
+	poolID uuid.UUID = uuid.MustParse("1a2d6a39-5bd4-4cb0-9bd7-0b1a4b6d0c7e")
+)
+
+func TestListClusters(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters", loc), r.RequestURI)
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	k.ListClusters(context.Background())
+}
+
+func TestCreateCluster(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters", loc), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, "Test", data["name"])
+		assert.Equal(t, float64(123), data["billing_account_id"])
+
+		pools := data["node_pools"].([]interface{})
+		assert.Equal(t, float64(3), pools[0].(map[string]interface{})["node_count"])
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	cfg := CreateClusterConfig{
+		Name:      "Test",
+		NodePools: []NodePoolConfig{{Name: "default", NodeCount: 3, VCPU: 2, RAM: 4096, DiskSize: 40}},
+	}
+
+	// BillingAccountID not set
+	_, err := k.CreateCluster(context.Background(), cfg)
+	assert.Error(t, err)
+
+	// Success
+	cfg.BillingAccountID = 123
+	k.CreateCluster(context.Background(), cfg)
+}
+
+func TestCreateClusterConfig_Validate(t *testing.T) {
+	cfg := CreateClusterConfig{Name: "Test", BillingAccountID: 123}
+	assert.EqualError(t, cfg.Validate(), "NodePools with value of [] is invalid, must have at least 1 node pool")
+
+	cfg.NodePools = []NodePoolConfig{{Name: "default", NodeCount: 1}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Name = ""
+	assert.EqualError(t, cfg.Validate(), `Name with value of "" is invalid`)
+}
+
+func TestGetCluster(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s", loc, id), r.RequestURI)
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	k.GetCluster(context.Background(), id)
+}
+
+func TestDeleteCluster(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s", loc, id), r.RequestURI)
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	k.DeleteCluster(context.Background(), id)
+}
+
+func TestScaleNodePool(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools/%s", loc, id, poolID), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, float64(5), data["node_count"])
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+
+	// invalid node count
+	_, err := k.ScaleNodePool(context.Background(), id, poolID, 0)
+	assert.Error(t, err)
+
+	k.ScaleNodePool(context.Background(), id, poolID, 5)
+}
+
+func TestGetKubeconfig(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/kubeconfig", loc, id), r.RequestURI)
+		w.Write([]byte("apiVersion: v1"))
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	b, err := k.GetKubeconfig(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, "apiVersion: v1", string(b))
+}
+
+func TestWriteKubeconfig(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("apiVersion: v1"))
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	assert.NoError(t, k.WriteKubeconfig(context.Background(), id, path))
+
+	b, _ := os.ReadFile(path)
+	assert.Equal(t, "apiVersion: v1", string(b))
+	info, _ := os.Stat(path)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestListClustersIterator(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters?limit=2&page=1", loc), r.RequestURI)
+		w.Write([]byte("[{}]"))
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	it := k.ListClustersIterator(2)
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
@@ -0,0 +1,90 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+)
+
+// ListNodePools https://api.warren.io/#list-node-pools
+func (c *Client) ListNodePools(ctx context.Context, id uuid.UUID) (*[]NodePool, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools", c.Location, id),
+	}
+	var pools []NodePool
+	if err := c.API.JSONRequest(ctx, rc).Into(&pools); err != nil {
+		return nil, err
+	}
+	return &pools, nil
+}
+
+// CreateNodePool https://api.warren.io/#create-node-pool
+func (c *Client) CreateNodePool(ctx context.Context, id uuid.UUID, cfg NodePoolConfig) (*NodePool, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools", c.Location, id),
+		JSON:   cfg,
+	}
+	var pool NodePool
+	if err := c.API.JSONRequest(ctx, rc).Into(&pool); err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// GetNodePool https://api.warren.io/#get-node-pool
+func (c *Client) GetNodePool(ctx context.Context, id, poolID uuid.UUID) (*NodePool, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools/%s", c.Location, id, poolID),
+	}
+	var pool NodePool
+	if err := c.API.JSONRequest(ctx, rc).Into(&pool); err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+// DeleteNodePool https://api.warren.io/#delete-node-pool
+func (c *Client) DeleteNodePool(ctx context.Context, id, poolID uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools/%s", c.Location, id, poolID),
+	}
+	return c.API.JSONRequest(ctx, rc).Error
+}
+
+// WaitForNodePool polls node pool until it is ready or ctx is done, and returns the latest node pool.
+// Use it after `CreateNodePool()` or `ScaleNodePool()`.
+func (c *Client) WaitForNodePool(ctx context.Context, id, poolID uuid.UUID, opts ...waiter.Option) (*NodePool, error) {
+	var pool *NodePool
+	err := waiter.ForStatus(ctx, func(ctx context.Context) (string, error) {
+		p, err := c.GetNodePool(ctx, id, poolID)
+		if err != nil {
+			return "", err
+		}
+		pool = p
+		return p.Status, nil
+	}, NodePoolStatusReady, opts...)
+	return pool, err
+}
+
+// WaitForNodePoolDeleted polls node pool until it no longer exists or ctx is done.
+func (c *Client) WaitForNodePoolDeleted(ctx context.Context, id, poolID uuid.UUID, opts ...waiter.Option) error {
+	return waiter.Until(ctx, func(ctx context.Context) (bool, error) {
+		_, err := c.GetNodePool(ctx, id, poolID)
+		if errors.Is(err, api.ErrNotFound) {
+			return true, nil
+		}
+		return false, err
+	}, opts...)
+}
@@ -0,0 +1,124 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListNodePools(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools", loc, id), r.RequestURI)
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	k.ListNodePools(context.Background(), id)
+}
+
+func TestCreateNodePool(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools", loc, id), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, "gpu", data["name"])
+		assert.Equal(t, float64(2), data["node_count"])
+		assert.Equal(t, map[string]interface{}{"role": "gpu"}, data["labels"])
+
+		taints := data["taints"].([]interface{})
+		assert.Equal(t, TaintEffectNoSchedule, taints[0].(map[string]interface{})["effect"])
+		w.Write([]byte("{}"))
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	cfg := NodePoolConfig{
+		Name:      "gpu",
+		NodeCount: 2,
+		VCPU:      8,
+		RAM:       16384,
+		DiskSize:  100,
+		Labels:    map[string]string{"role": "gpu"},
+		Taints:    []Taint{{Key: "gpu", Effect: "Never"}},
+	}
+
+	// invalid taint
+	_, err := k.CreateNodePool(context.Background(), id, cfg)
+	assert.EqualError(t, err, "Effect with value of Never is invalid, must be one of NoSchedule, PreferNoSchedule, NoExecute")
+
+	// invalid node count
+	cfg.Taints[0].Effect = TaintEffectNoSchedule
+	cfg.NodeCount = 0
+	_, err = k.CreateNodePool(context.Background(), id, cfg)
+	assert.EqualError(t, err, "NodeCount with value of 0 is invalid")
+
+	// Success
+	cfg.NodeCount = 2
+	_, err = k.CreateNodePool(context.Background(), id, cfg)
+	assert.NoError(t, err)
+}
+
+func TestGetNodePool(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools/%s", loc, id, poolID), r.RequestURI)
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	k.GetNodePool(context.Background(), id, poolID)
+}
+
+func TestDeleteNodePool(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools/%s", loc, id, poolID), r.RequestURI)
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	k.DeleteNodePool(context.Background(), id, poolID)
+}
+
+func TestWaitForNodePool(t *testing.T) {
+	statuses := []string{NodePoolStatusProvisioning, NodePoolStatusReady}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v1/%s/kubernetes/clusters/%s/node_pools/%s", loc, id, poolID), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, poolID, statuses[0])))
+		statuses = statuses[1:]
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	pool, err := k.WaitForNodePool(context.Background(), id, poolID, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, NodePoolStatusReady, pool.Status)
+}
+
+func TestWaitForNodePoolDeleted(t *testing.T) {
+	calls := 0
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls > 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, poolID, NodePoolStatusDeleting)))
+	})
+	defer s.Close()
+
+	k := Client{API: a, Location: loc}
+	err := k.WaitForNodePoolDeleted(context.Background(), id, poolID, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,130 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+type Client struct {
+	API      *api.API
+	Location string
+}
+
+// Node pool statuses
+const (
+	NodePoolStatusProvisioning = "provisioning"
+	NodePoolStatusReady        = "ready"
+	NodePoolStatusDeleting     = "deleting"
+)
+
+// Taint effects, see https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/
+const (
+	TaintEffectNoSchedule       = "NoSchedule"
+	TaintEffectPreferNoSchedule = "PreferNoSchedule"
+	TaintEffectNoExecute        = "NoExecute"
+)
+
+// Taint is applied to every node in a pool, Value is optional.
+type Taint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// Validate checks that taint has a key and known effect.
+func (t Taint) Validate() error {
+	if t.Key == "" {
+		return fmt.Errorf("Key with value of %q is invalid", t.Key)
+	}
+	switch t.Effect {
+	case TaintEffectNoSchedule, TaintEffectPreferNoSchedule, TaintEffectNoExecute:
+		return nil
+	}
+	return fmt.Errorf("Effect with value of %v is invalid, must be one of %s, %s, %s",
+		t.Effect, TaintEffectNoSchedule, TaintEffectPreferNoSchedule, TaintEffectNoExecute)
+}
+
+// NodePool is a group of worker nodes with identical size, RAM is in MB and DiskSize in GB.
+type NodePool struct {
+	UUID      uuid.UUID         `json:"uuid"`
+	Name      string            `json:"name"`
+	Status    string            `json:"status"`
+	NodeCount int               `json:"node_count"`
+	VCPU      int               `json:"vcpu"`
+	RAM       int               `json:"ram"`
+	DiskSize  int               `json:"disk_size"`
+	Labels    map[string]string `json:"labels"`
+	Taints    []Taint           `json:"taints"`
+	CreatedAt string            `json:"created_at"`
+	UpdatedAt string            `json:"updated_at"`
+}
+
+// Cluster represents managed Kubernetes cluster
+type Cluster struct {
+	UUID             uuid.UUID  `json:"uuid"`
+	Name             string     `json:"name"`
+	Version          string     `json:"version"`
+	Status           string     `json:"status"`
+	BillingAccountID int        `json:"billing_account_id"`
+	NetworkUUID      uuid.UUID  `json:"network_uuid"`
+	NodePools        []NodePool `json:"node_pools"`
+	CreatedAt        string     `json:"created_at"`
+	UpdatedAt        string     `json:"updated_at"`
+}
+
+// NodePoolConfig holds parameters to create a node pool
+type NodePoolConfig struct {
+	Name      string            `json:"name"`
+	NodeCount int               `json:"node_count"`
+	VCPU      int               `json:"vcpu"`
+	RAM       int               `json:"ram"`
+	DiskSize  int               `json:"disk_size"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Taints    []Taint           `json:"taints,omitempty"`
+}
+
+// Validate checks NodePoolConfig before it's sent to the API.
+func (p NodePoolConfig) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("Name with value of %q is invalid", p.Name)
+	}
+	if p.NodeCount < 1 {
+		return fmt.Errorf("NodeCount with value of %v is invalid", p.NodeCount)
+	}
+	for _, t := range p.Taints {
+		if err := t.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateClusterConfig holds parameters to create a new cluster
+type CreateClusterConfig struct {
+	Name             string           `json:"name"`
+	Version          string           `json:"version,omitempty"`
+	BillingAccountID int              `json:"billing_account_id"`
+	NetworkUUID      *uuid.UUID       `json:"network_uuid,omitempty"`
+	NodePools        []NodePoolConfig `json:"node_pools"`
+}
+
+// Validate checks fields that are required by the API, it's called by `Client.CreateCluster()` before sending the request.
+func (cfg CreateClusterConfig) Validate() error {
+	if cfg.Name == "" {
+		return fmt.Errorf("Name with value of %q is invalid", cfg.Name)
+	}
+	if cfg.BillingAccountID == 0 {
+		return fmt.Errorf("BillingAccountID with value of %v is invalid", cfg.BillingAccountID)
+	}
+	if len(cfg.NodePools) == 0 {
+		return fmt.Errorf("NodePools with value of %v is invalid, must have at least 1 node pool", cfg.NodePools)
+	}
+	for _, p := range cfg.NodePools {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package lb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+func NewClient(client *api.API, location string) *Client {
+	return &Client{
+		API:      client,
+		Location: location,
+	}
+}
+
+// ListLoadBalancers https://api.warren.io/#list-load-balancers
+func (c *Client) ListLoadBalancers(ctx context.Context) (*[]LoadBalancer, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/network/load_balancers", c.Location),
+	}
+	var lbs []LoadBalancer
+	if err := c.API.JSONRequest(ctx, rc).Into(&lbs); err != nil {
+		return nil, err
+	}
+	return &lbs, nil
+}
+
+// ListLoadBalancersIterator returns iterator over `ListLoadBalancers()` results, fetching limit items per page.
+func (c *Client) ListLoadBalancersIterator(limit int) *api.Iterator[LoadBalancer] {
+	return api.NewIterator(func(ctx context.Context, page, limit int) ([]LoadBalancer, error) {
+		rc := api.RequestConfig{
+			Method: "GET",
+			Path:   fmt.Sprintf("/v1/%s/network/load_balancers", c.Location),
+			Page:   page,
+			Limit:  limit,
+		}
+		var lbs []LoadBalancer
+		if err := c.API.JSONRequest(ctx, rc).Into(&lbs); err != nil {
+			return nil, err
+		}
+		return lbs, nil
+	}, limit)
+}
+
+// CreateLoadBalancer https://api.warren.io/#create-load-balancer
+func (c *Client) CreateLoadBalancer(ctx context.Context, cfg CreateLoadBalancerConfig) (*LoadBalancer, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	hc := HealthCheck{}
+	if cfg.HealthCheck != nil {
+		hc = *cfg.HealthCheck
+	}
+	hc = hc.WithDefaults()
+	cfg.HealthCheck = &hc
+
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/network/load_balancers", c.Location),
+		JSON:   cfg,
+	}
+	var lb LoadBalancer
+	if err := c.API.JSONRequest(ctx, rc).Into(&lb); err != nil {
+		return nil, err
+	}
+	return &lb, nil
+}
+
+// GetLoadBalancer https://api.warren.io/#get-load-balancer
+func (c *Client) GetLoadBalancer(ctx context.Context, id uuid.UUID) (*LoadBalancer, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/network/load_balancers/%s", c.Location, id),
+	}
+	var lb LoadBalancer
+	if err := c.API.JSONRequest(ctx, rc).Into(&lb); err != nil {
+		return nil, err
+	}
+	return &lb, nil
+}
+
+// DeleteLoadBalancer https://api.warren.io/#delete-load-balancer
+func (c *Client) DeleteLoadBalancer(ctx context.Context, id uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/network/load_balancers/%s", c.Location, id),
+	}
+	return c.API.JSONRequest(ctx, rc).Error
+}
+
+// UpdateHealthCheck https://api.warren.io/#update-load-balancer
+// Zero-valued fields are set to defaults, see `HealthCheck.WithDefaults()`.
+func (c *Client) UpdateHealthCheck(ctx context.Context, id uuid.UUID, hc HealthCheck) (*LoadBalancer, error) {
+	hc = hc.WithDefaults()
+	if err := hc.Validate(); err != nil {
+		return nil, err
+	}
+	rc := api.RequestConfig{
+		Method: "PATCH",
+		Path:   fmt.Sprintf("/v1/%s/network/load_balancers/%s", c.Location, id),
+		JSON:   map[string]interface{}{"health_check": hc},
+	}
+	var lb LoadBalancer
+	if err := c.API.JSONRequest(ctx, rc).Into(&lb); err != nil {
+		return nil, err
+	}
+	return &lb, nil
+}
+
+// AddForwardingRule https://api.warren.io/#add-forwarding-rule
+func (c *Client) AddForwardingRule(ctx context.Context, id uuid.UUID, cfg ForwardingRuleConfig) (*ForwardingRule, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/network/load_balancers/%s/forwarding_rules", c.Location, id),
+		JSON:   cfg,
+	}
+	var rule ForwardingRule
+	if err := c.API.JSONRequest(ctx, rc).Into(&rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// DeleteForwardingRule https://api.warren.io/#delete-forwarding-rule
+func (c *Client) DeleteForwardingRule(ctx context.Context, id, ruleID uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/network/load_balancers/%s/forwarding_rules/%s", c.Location, id, ruleID),
+	}
+	return c.API.JSONRequest(ctx, rc).Error
+}
+
+// AddTarget https://api.warren.io/#add-target
+// Existing target for the same resource is returned instead of adding a duplicate, so it's safe to retry.
+func (c *Client) AddTarget(ctx context.Context, id uuid.UUID, cfg TargetConfig) (*Target, error) {
+	lb, err := c.GetLoadBalancer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range lb.Targets {
+		if t.TargetUUID == cfg.TargetUUID {
+			return &t, nil
+		}
+	}
+	return c.addTarget(ctx, id, cfg)
+}
+
+// addTarget adds target without checking whether it already exists.
+func (c *Client) addTarget(ctx context.Context, id uuid.UUID, cfg TargetConfig) (*Target, error) {
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/network/load_balancers/%s/targets", c.Location, id),
+		JSON:   cfg,
+	}
+	var target Target
+	if err := c.API.JSONRequest(ctx, rc).Into(&target); err != nil {
+		return nil, err
+	}
+	return &target, nil
+}
+
+// RemoveTarget https://api.warren.io/#remove-target
+func (c *Client) RemoveTarget(ctx context.Context, id, targetID uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/network/load_balancers/%s/targets/%s", c.Location, id, targetID),
+	}
+	return c.API.JSONRequest(ctx, rc).Error
+}
+
+// EnsureTargets adds VMs that are not yet targets of the load balancer, existing targets are left untouched.
+// Traffic ports are configured by forwarding rules, see `AddForwardingRule()`.
+func (c *Client) EnsureTargets(ctx context.Context, id uuid.UUID, vmIDs []uuid.UUID) (*[]Target, error) {
+	return c.reconcileTargets(ctx, id, vmIDs, false)
+}
+
+// ReplaceTargets makes VMs the only targets of the load balancer, adding missing ones and removing the rest.
+// Calling it again with the same VMs makes no changes so it's safe to retry.
+func (c *Client) ReplaceTargets(ctx context.Context, id uuid.UUID, vmIDs []uuid.UUID) (*[]Target, error) {
+	return c.reconcileTargets(ctx, id, vmIDs, true)
+}
+
+// reconcileTargets adds missing VM targets and, when remove is set, removes targets not in vmIDs.
+// Targets already gone by the time they're removed are ignored.
+func (c *Client) reconcileTargets(ctx context.Context, id uuid.UUID, vmIDs []uuid.UUID, remove bool) (*[]Target, error) {
+	lb, err := c.GetLoadBalancer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[uuid.UUID]bool, len(vmIDs))
+	for _, vmID := range vmIDs {
+		wanted[vmID] = true
+	}
+
+	targets := []Target{}
+	for _, t := range lb.Targets {
+		if wanted[t.TargetUUID] || !remove {
+			targets = append(targets, t)
+			continue
+		}
+		if err := c.RemoveTarget(ctx, id, t.TargetUUID); err != nil && !errors.Is(err, api.ErrNotFound) {
+			return nil, err
+		}
+	}
+	for _, vmID := range vmIDs {
+		if lb.HasTarget(vmID) {
+			continue
+		}
+		t, err := c.addTarget(ctx, id, TargetConfig{TargetUUID: vmID, TargetType: TargetTypeVM})
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, *t)
+		// guard against duplicated ids
+		lb.Targets = append(lb.Targets, *t)
+	}
+	return &targets, nil
+}
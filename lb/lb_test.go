@@ -0,0 +1,317 @@
+package lb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	loc      string    = "jkt01"
+	id       uuid.UUID = uuid.MustParse("4e5eadd3-8b11-4c34-812a-2cf97120b628")
+	targetID uuid.UUID = uuid.MustParse("1a2d6a39-5bd4-4cb0-9bd7-0b1a4b6d0c7e")
+)
+
+func TestListLoadBalancers(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers", loc), r.RequestURI)
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	lb.ListLoadBalancers(context.Background())
+}
+
+func TestCreateLoadBalancer(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers", loc), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, "Test", data["display_name"])
+		assert.Equal(t, float64(123), data["billing_account_id"])
+		assert.NotContains(t, data, "network_uuid")
+
+		rules := data["forwarding_rules"].([]interface{})
+		assert.Equal(t, float64(80), rules[0].(map[string]interface{})["source_port"])
+		targets := data["targets"].([]interface{})
+		assert.Equal(t, targetID.String(), targets[0].(map[string]interface{})["target_uuid"])
+		hc := data["health_check"].(map[string]interface{})
+		assert.Equal(t, "TCP", hc["protocol"])
+		assert.Equal(t, float64(10), hc["interval_seconds"])
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	cfg := CreateLoadBalancerConfig{
+		DisplayName:     "Test",
+		ForwardingRules: []ForwardingRuleConfig{{Protocol: "TCP", SourcePort: 80, TargetPort: 8080}},
+		Targets:         []TargetConfig{{TargetUUID: targetID, TargetType: TargetTypeVM}},
+	}
+
+	// BillingAccountID not set
+	_, err := lb.CreateLoadBalancer(context.Background(), cfg)
+	assert.Error(t, err)
+
+	// Success
+	cfg.BillingAccountID = 123
+	lb.CreateLoadBalancer(context.Background(), cfg)
+}
+
+func TestCreateLoadBalancer_InvalidHealthCheck(t *testing.T) {
+	lb := Client{Location: loc}
+	cfg := CreateLoadBalancerConfig{
+		BillingAccountID: 123,
+		HealthCheck:      &HealthCheck{Protocol: "UDP"},
+	}
+	_, err := lb.CreateLoadBalancer(context.Background(), cfg)
+	assert.EqualError(t, err, "Protocol with value of UDP is invalid, must be one of TCP, HTTP, HTTPS")
+}
+
+func TestCreateLoadBalancerConfig_Validate(t *testing.T) {
+	cfg := CreateLoadBalancerConfig{
+		BillingAccountID: 123,
+		ForwardingRules:  []ForwardingRuleConfig{{Protocol: "TCP", SourcePort: 80, TargetPort: 8080}},
+		Targets:          []TargetConfig{{TargetUUID: targetID, TargetType: TargetTypeVM}},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.ForwardingRules[0].TargetPort = 70000
+	assert.EqualError(t, cfg.Validate(), "TargetPort with value of 70000 is invalid, must be between 1 and 65535")
+
+	cfg.ForwardingRules[0].TargetPort = 8080
+	cfg.Targets[0].TargetUUID = uuid.Nil
+	assert.EqualError(t, cfg.Validate(), "TargetUUID with value of 00000000-0000-0000-0000-000000000000 is invalid")
+}
+
+func TestHealthCheck_WithDefaults(t *testing.T) {
+	assert.Equal(t, HealthCheck{
+		Protocol:           "TCP",
+		IntervalSeconds:    10,
+		TimeoutSeconds:     5,
+		HealthyThreshold:   3,
+		UnhealthyThreshold: 3,
+	}, HealthCheck{}.WithDefaults())
+
+	hc := HealthCheck{Protocol: HealthCheckHTTP, IntervalSeconds: 30}.WithDefaults()
+	assert.Equal(t, "/", hc.Path)
+	assert.Equal(t, 30, hc.IntervalSeconds)
+}
+
+func TestHealthCheck_Validate(t *testing.T) {
+	valid := HealthCheck{}.WithDefaults()
+	assert.NoError(t, valid.Validate())
+
+	hc := valid
+	hc.Protocol = HealthCheckHTTP
+	hc.Path = "health"
+	assert.EqualError(t, hc.Validate(), "Path with value of health is invalid, must start with /")
+
+	hc = valid
+	hc.IntervalSeconds = 1
+	assert.EqualError(t, hc.Validate(), "IntervalSeconds with value of 1 is invalid, must be between 2 and 300")
+
+	hc = valid
+	hc.TimeoutSeconds = 10
+	assert.EqualError(t, hc.Validate(), "TimeoutSeconds with value of 10 is invalid, must be at least 1 and less than IntervalSeconds (10)")
+
+	// timeout must be strictly less than interval
+	hc.IntervalSeconds = 11
+	assert.NoError(t, hc.Validate())
+	hc.TimeoutSeconds = 0
+	assert.EqualError(t, hc.Validate(), "TimeoutSeconds with value of 0 is invalid, must be at least 1 and less than IntervalSeconds (11)")
+
+	hc = valid
+	hc.HealthyThreshold = 11
+	assert.EqualError(t, hc.Validate(), "HealthyThreshold with value of 11 is invalid, must be between 1 and 10")
+
+	hc = valid
+	hc.UnhealthyThreshold = -1
+	assert.EqualError(t, hc.Validate(), "UnhealthyThreshold with value of -1 is invalid, must be between 1 and 10")
+}
+
+func TestUpdateHealthCheck(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers/%s", loc, id), r.RequestURI)
+
+		var data map[string]map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, "HTTP", data["health_check"]["protocol"])
+		assert.Equal(t, "/healthz", data["health_check"]["path"])
+		assert.Equal(t, float64(3), data["health_check"]["healthy_threshold"])
+		w.Write([]byte(`{"health_check":{"protocol":"HTTP","path":"/healthz"}}`))
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	updated, err := lb.UpdateHealthCheck(context.Background(), id, HealthCheck{Protocol: HealthCheckHTTP, Path: "/healthz"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/healthz", updated.HealthCheck.Path)
+}
+
+func TestGetLoadBalancer(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers/%s", loc, id), r.RequestURI)
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	lb.GetLoadBalancer(context.Background(), id)
+}
+
+func TestDeleteLoadBalancer(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers/%s", loc, id), r.RequestURI)
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	lb.DeleteLoadBalancer(context.Background(), id)
+}
+
+func TestAddForwardingRule(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers/%s/forwarding_rules", loc, id), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, "TCP", data["protocol"])
+		assert.Equal(t, float64(443), data["source_port"])
+		assert.Equal(t, float64(8443), data["target_port"])
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	lb.AddForwardingRule(context.Background(), id, ForwardingRuleConfig{Protocol: "TCP", SourcePort: 443, TargetPort: 8443})
+}
+
+func TestDeleteForwardingRule(t *testing.T) {
+	ruleID := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers/%s/forwarding_rules/%s", loc, id, ruleID), r.RequestURI)
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	lb.DeleteForwardingRule(context.Background(), id, ruleID)
+}
+
+func TestAddTarget(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers/%s", loc, id), r.RequestURI)
+			w.Write([]byte(`{"targets":[]}`))
+			return
+		}
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers/%s/targets", loc, id), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, targetID.String(), data["target_uuid"])
+		assert.Equal(t, TargetTypeVM, data["target_type"])
+		w.Write([]byte(fmt.Sprintf(`{"target_uuid":"%s","target_type":"vm"}`, targetID)))
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	target, err := lb.AddTarget(context.Background(), id, TargetConfig{TargetUUID: targetID, TargetType: TargetTypeVM})
+	assert.NoError(t, err)
+	assert.Equal(t, targetID, target.TargetUUID)
+}
+
+func TestAddTarget_Exists(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		// already a target, nothing is added
+		assert.Equal(t, "GET", r.Method)
+		w.Write([]byte(fmt.Sprintf(`{"targets":[{"target_uuid":"%s","target_type":"vm","target_ip_address":"10.0.0.2"}]}`, targetID)))
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	target, err := lb.AddTarget(context.Background(), id, TargetConfig{TargetUUID: targetID, TargetType: TargetTypeVM})
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.2", target.TargetIPAddress)
+}
+
+func TestRemoveTarget(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers/%s/targets/%s", loc, id, targetID), r.RequestURI)
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	lb.RemoveTarget(context.Background(), id, targetID)
+}
+
+func TestListLoadBalancersIterator(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers?limit=2&page=1", loc), r.RequestURI)
+		w.Write([]byte("[{}]"))
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	it := lb.ListLoadBalancersIterator(2)
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
+
+func TestReplaceTargets(t *testing.T) {
+	keep, stale, added := uuid.New(), uuid.New(), uuid.New()
+	var removed, posted []string
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			assert.Equal(t, fmt.Sprintf("/v1/%s/network/load_balancers/%s", loc, id), r.RequestURI)
+			fmt.Fprintf(w, `{"targets":[{"target_uuid":"%s"},{"target_uuid":"%s"}]}`, keep, stale)
+		case "DELETE":
+			removed = append(removed, r.RequestURI)
+			w.WriteHeader(http.StatusNotFound)
+		case "POST":
+			var data map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&data)
+			posted = append(posted, data["target_uuid"].(string))
+			assert.Equal(t, TargetTypeVM, data["target_type"])
+			fmt.Fprintf(w, `{"target_uuid":"%s"}`, data["target_uuid"])
+		}
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	targets, err := lb.ReplaceTargets(context.Background(), id, []uuid.UUID{keep, added, added})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("/v1/%s/network/load_balancers/%s/targets/%s", loc, id, stale)}, removed)
+	assert.Equal(t, []string{added.String()}, posted)
+	assert.Len(t, *targets, 2)
+}
+
+func TestEnsureTargets(t *testing.T) {
+	keep, other := uuid.New(), uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprintf(w, `{"targets":[{"target_uuid":"%s"},{"target_uuid":"%s"}]}`, keep, other)
+	})
+	defer s.Close()
+
+	lb := Client{API: a, Location: loc}
+	targets, err := lb.EnsureTargets(context.Background(), id, []uuid.UUID{keep})
+	assert.NoError(t, err)
+	assert.Len(t, *targets, 2)
+}
@@ -0,0 +1,194 @@
+package lb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+type Client struct {
+	API      *api.API
+	Location string
+}
+
+// TargetTypeVM is the only target type supported by the API
+const TargetTypeVM = "vm"
+
+// ForwardingRule forwards traffic from load balancer's SourcePort to targets' TargetPort
+type ForwardingRule struct {
+	UUID       uuid.UUID `json:"uuid"`
+	Protocol   string    `json:"protocol"`
+	SourcePort int       `json:"source_port"`
+	TargetPort int       `json:"target_port"`
+	CreatedAt  string    `json:"created_at"`
+}
+
+// ForwardingRuleConfig holds parameters to create a forwarding rule
+type ForwardingRuleConfig struct {
+	Protocol   string `json:"protocol"`
+	SourcePort int    `json:"source_port"`
+	TargetPort int    `json:"target_port"`
+}
+
+// Validate checks that ports are within 1-65535.
+func (cfg ForwardingRuleConfig) Validate() error {
+	if cfg.SourcePort < 1 || cfg.SourcePort > 65535 {
+		return fmt.Errorf("SourcePort with value of %v is invalid, must be between 1 and 65535", cfg.SourcePort)
+	}
+	if cfg.TargetPort < 1 || cfg.TargetPort > 65535 {
+		return fmt.Errorf("TargetPort with value of %v is invalid, must be between 1 and 65535", cfg.TargetPort)
+	}
+	return nil
+}
+
+// Target is a backend resource that receives traffic from load balancer
+type Target struct {
+	TargetUUID      uuid.UUID `json:"target_uuid"`
+	TargetType      string    `json:"target_type"`
+	TargetIPAddress string    `json:"target_ip_address"`
+	CreatedAt       string    `json:"created_at"`
+}
+
+// TargetConfig holds parameters to add a target
+type TargetConfig struct {
+	TargetUUID uuid.UUID `json:"target_uuid"`
+	TargetType string    `json:"target_type"`
+}
+
+// Health check protocols
+const (
+	HealthCheckTCP   = "TCP"
+	HealthCheckHTTP  = "HTTP"
+	HealthCheckHTTPS = "HTTPS"
+)
+
+// Health check defaults, applied to zero-valued fields
+const (
+	DefaultHealthCheckInterval = 10
+	DefaultHealthCheckTimeout  = 5
+	DefaultHealthyThreshold    = 3
+	DefaultUnhealthyThreshold  = 3
+	DefaultHealthCheckHTTPPath = "/"
+	DefaultHealthCheckProtocol = HealthCheckTCP
+)
+
+// HealthCheck decides whether target receives traffic, interval and timeout are in seconds.
+// Path is only used by HTTP and HTTPS checks.
+type HealthCheck struct {
+	Protocol           string `json:"protocol"`
+	Path               string `json:"path,omitempty"`
+	IntervalSeconds    int    `json:"interval_seconds"`
+	TimeoutSeconds     int    `json:"timeout_seconds"`
+	HealthyThreshold   int    `json:"healthy_threshold"`
+	UnhealthyThreshold int    `json:"unhealthy_threshold"`
+}
+
+// WithDefaults returns copy of health check with zero-valued fields set to defaults.
+func (h HealthCheck) WithDefaults() HealthCheck {
+	if h.Protocol == "" {
+		h.Protocol = DefaultHealthCheckProtocol
+	}
+	if h.Path == "" && h.Protocol != HealthCheckTCP {
+		h.Path = DefaultHealthCheckHTTPPath
+	}
+	if h.IntervalSeconds == 0 {
+		h.IntervalSeconds = DefaultHealthCheckInterval
+	}
+	if h.TimeoutSeconds == 0 {
+		h.TimeoutSeconds = DefaultHealthCheckTimeout
+	}
+	if h.HealthyThreshold == 0 {
+		h.HealthyThreshold = DefaultHealthyThreshold
+	}
+	if h.UnhealthyThreshold == 0 {
+		h.UnhealthyThreshold = DefaultUnhealthyThreshold
+	}
+	return h
+}
+
+// Validate checks health check settings, call it after `WithDefaults()`.
+func (h HealthCheck) Validate() error {
+	switch h.Protocol {
+	case HealthCheckTCP, HealthCheckHTTP, HealthCheckHTTPS:
+	default:
+		return fmt.Errorf("Protocol with value of %v is invalid, must be one of TCP, HTTP, HTTPS", h.Protocol)
+	}
+	if h.Protocol != HealthCheckTCP && !strings.HasPrefix(h.Path, "/") {
+		return fmt.Errorf("Path with value of %v is invalid, must start with /", h.Path)
+	}
+	if h.IntervalSeconds < 2 || h.IntervalSeconds > 300 {
+		return fmt.Errorf("IntervalSeconds with value of %v is invalid, must be between 2 and 300", h.IntervalSeconds)
+	}
+	if h.TimeoutSeconds < 1 || h.TimeoutSeconds >= h.IntervalSeconds {
+		return fmt.Errorf("TimeoutSeconds with value of %v is invalid, must be at least 1 and less than IntervalSeconds (%d)", h.TimeoutSeconds, h.IntervalSeconds)
+	}
+	if h.HealthyThreshold < 1 || h.HealthyThreshold > 10 {
+		return fmt.Errorf("HealthyThreshold with value of %v is invalid, must be between 1 and 10", h.HealthyThreshold)
+	}
+	if h.UnhealthyThreshold < 1 || h.UnhealthyThreshold > 10 {
+		return fmt.Errorf("UnhealthyThreshold with value of %v is invalid, must be between 1 and 10", h.UnhealthyThreshold)
+	}
+	return nil
+}
+
+// LoadBalancer represents load balancer
+type LoadBalancer struct {
+	UUID             uuid.UUID        `json:"uuid"`
+	DisplayName      string           `json:"display_name"`
+	UserID           int              `json:"user_id"`
+	BillingAccountID int              `json:"billing_account_id"`
+	NetworkUUID      uuid.UUID        `json:"network_uuid"`
+	PrivateAddress   string           `json:"private_address"`
+	ForwardingRules  []ForwardingRule `json:"forwarding_rules"`
+	Targets          []Target         `json:"targets"`
+	HealthCheck      *HealthCheck     `json:"health_check"`
+	IsDeleted        bool             `json:"is_deleted"`
+	CreatedAt        string           `json:"created_at"`
+	UpdatedAt        string           `json:"updated_at"`
+}
+
+// HasTarget tells whether resource with given UUID is a target of load balancer.
+func (lb LoadBalancer) HasTarget(id uuid.UUID) bool {
+	for _, t := range lb.Targets {
+		if t.TargetUUID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateLoadBalancerConfig holds parameters to create a new load balancer,
+// HealthCheck defaults to TCP check, see `HealthCheck.WithDefaults()`.
+type CreateLoadBalancerConfig struct {
+	DisplayName      string                 `json:"display_name"`
+	BillingAccountID int                    `json:"billing_account_id"`
+	NetworkUUID      *uuid.UUID             `json:"network_uuid,omitempty"`
+	ReservePublicIP  bool                   `json:"reserve_public_ip"`
+	ForwardingRules  []ForwardingRuleConfig `json:"forwarding_rules"`
+	Targets          []TargetConfig         `json:"targets"`
+	HealthCheck      *HealthCheck           `json:"health_check,omitempty"`
+}
+
+// Validate checks fields that are required by the API, it's called by `Client.CreateLoadBalancer()` before sending the request.
+// HealthCheck is validated with defaults applied.
+func (cfg CreateLoadBalancerConfig) Validate() error {
+	if cfg.BillingAccountID == 0 {
+		return fmt.Errorf("BillingAccountID with value of %v is invalid", cfg.BillingAccountID)
+	}
+	for _, r := range cfg.ForwardingRules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, t := range cfg.Targets {
+		if t.TargetUUID == uuid.Nil {
+			return fmt.Errorf("TargetUUID with value of %v is invalid", t.TargetUUID)
+		}
+	}
+	if cfg.HealthCheck != nil {
+		return cfg.HealthCheck.WithDefaults().Validate()
+	}
+	return nil
+}
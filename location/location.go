@@ -2,7 +2,7 @@ package location
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 
 	"github.com/ekaputra07/warren-go/api"
 )
@@ -29,19 +29,44 @@ type Client struct {
 }
 
 // ListLocations https://api.warren.io/#list-locations
+// Response is cached when API has cache enabled, see `api.WithCache()`.
 func (c *Client) ListLocations(ctx context.Context) (*[]Location, error) {
 	rc := api.RequestConfig{
-		Method: "GET",
-		Path:   "/v1/config/locations",
+		Method:    "GET",
+		Path:      "/v1/config/locations",
+		Cacheable: true,
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return nil, resp.Error
-	}
-
 	var locations []Location
-	if err := json.Unmarshal(resp.Body, &locations); err != nil {
+	if err := c.API.FormRequest(ctx, rc).Into(&locations); err != nil {
 		return nil, err
 	}
 	return &locations, nil
 }
+
+// GetLocation returns location with given slug.
+func (c *Client) GetLocation(ctx context.Context, slug string) (*Location, error) {
+	locations, err := c.ListLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range *locations {
+		if l.Slug == slug {
+			return &l, nil
+		}
+	}
+	return nil, fmt.Errorf("location %s not found", slug)
+}
+
+// GetDefaultLocation returns location that marked as default.
+func (c *Client) GetDefaultLocation(ctx context.Context) (*Location, error) {
+	locations, err := c.ListLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range *locations {
+		if l.IsDefault {
+			return &l, nil
+		}
+	}
+	return nil, fmt.Errorf("no default location found")
+}
@@ -19,3 +19,33 @@ func TestListLocations(t *testing.T) {
 	lc := Client{API: a}
 	lc.ListLocations(context.Background())
 }
+
+func TestGetLocation(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/config/locations", r.RequestURI)
+		w.Write([]byte(`[{"slug":"jkt01"},{"slug":"sgp01"}]`))
+	})
+	defer s.Close()
+
+	lc := Client{API: a}
+	l, err := lc.GetLocation(context.Background(), "sgp01")
+	assert.NoError(t, err)
+	assert.Equal(t, "sgp01", l.Slug)
+
+	l, err = lc.GetLocation(context.Background(), "ams01")
+	assert.Nil(t, l)
+	assert.EqualError(t, err, "location ams01 not found")
+}
+
+func TestGetDefaultLocation(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/config/locations", r.RequestURI)
+		w.Write([]byte(`[{"slug":"jkt01"},{"slug":"sgp01","is_default":true}]`))
+	})
+	defer s.Close()
+
+	lc := Client{API: a}
+	l, err := lc.GetDefaultLocation(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "sgp01", l.Slug)
+}
@@ -0,0 +1,3047 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mocks/service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	account "github.com/ekaputra07/warren-go/account"
+	api "github.com/ekaputra07/warren-go/api"
+	billing "github.com/ekaputra07/warren-go/billing"
+	blockstorage "github.com/ekaputra07/warren-go/blockstorage"
+	bulk "github.com/ekaputra07/warren-go/bulk"
+	dns "github.com/ekaputra07/warren-go/dns"
+	events "github.com/ekaputra07/warren-go/events"
+	firewall "github.com/ekaputra07/warren-go/firewall"
+	image "github.com/ekaputra07/warren-go/image"
+	ip "github.com/ekaputra07/warren-go/ip"
+	kubernetes "github.com/ekaputra07/warren-go/kubernetes"
+	lb "github.com/ekaputra07/warren-go/lb"
+	location "github.com/ekaputra07/warren-go/location"
+	objectstorage "github.com/ekaputra07/warren-go/objectstorage"
+	sshkey "github.com/ekaputra07/warren-go/sshkey"
+	token "github.com/ekaputra07/warren-go/token"
+	vm "github.com/ekaputra07/warren-go/vm"
+	vpc "github.com/ekaputra07/warren-go/vpc"
+	waiter "github.com/ekaputra07/warren-go/waiter"
+	webhook "github.com/ekaputra07/warren-go/webhook"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLocationService is a mock of LocationService interface.
+type MockLocationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLocationServiceMockRecorder
+}
+
+// MockLocationServiceMockRecorder is the mock recorder for MockLocationService.
+type MockLocationServiceMockRecorder struct {
+	mock *MockLocationService
+}
+
+// NewMockLocationService creates a new mock instance.
+func NewMockLocationService(ctrl *gomock.Controller) *MockLocationService {
+	mock := &MockLocationService{ctrl: ctrl}
+	mock.recorder = &MockLocationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLocationService) EXPECT() *MockLocationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetDefaultLocation mocks base method.
+func (m *MockLocationService) GetDefaultLocation(ctx context.Context) (*location.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultLocation", ctx)
+	ret0, _ := ret[0].(*location.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultLocation indicates an expected call of GetDefaultLocation.
+func (mr *MockLocationServiceMockRecorder) GetDefaultLocation(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultLocation", reflect.TypeOf((*MockLocationService)(nil).GetDefaultLocation), ctx)
+}
+
+// GetLocation mocks base method.
+func (m *MockLocationService) GetLocation(ctx context.Context, slug string) (*location.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocation", ctx, slug)
+	ret0, _ := ret[0].(*location.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocation indicates an expected call of GetLocation.
+func (mr *MockLocationServiceMockRecorder) GetLocation(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocation", reflect.TypeOf((*MockLocationService)(nil).GetLocation), ctx, slug)
+}
+
+// ListLocations mocks base method.
+func (m *MockLocationService) ListLocations(ctx context.Context) (*[]location.Location, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocations", ctx)
+	ret0, _ := ret[0].(*[]location.Location)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocations indicates an expected call of ListLocations.
+func (mr *MockLocationServiceMockRecorder) ListLocations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocations", reflect.TypeOf((*MockLocationService)(nil).ListLocations), ctx)
+}
+
+// MockObjectStorageService is a mock of ObjectStorageService interface.
+type MockObjectStorageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockObjectStorageServiceMockRecorder
+}
+
+// MockObjectStorageServiceMockRecorder is the mock recorder for MockObjectStorageService.
+type MockObjectStorageServiceMockRecorder struct {
+	mock *MockObjectStorageService
+}
+
+// NewMockObjectStorageService creates a new mock instance.
+func NewMockObjectStorageService(ctrl *gomock.Controller) *MockObjectStorageService {
+	mock := &MockObjectStorageService{ctrl: ctrl}
+	mock.recorder = &MockObjectStorageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockObjectStorageService) EXPECT() *MockObjectStorageServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateBucket mocks base method.
+func (m *MockObjectStorageService) CreateBucket(ctx context.Context, bucketName string) (*objectstorage.S3Bucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBucket", ctx, bucketName)
+	ret0, _ := ret[0].(*objectstorage.S3Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBucket indicates an expected call of CreateBucket.
+func (mr *MockObjectStorageServiceMockRecorder) CreateBucket(ctx, bucketName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucket", reflect.TypeOf((*MockObjectStorageService)(nil).CreateBucket), ctx, bucketName)
+}
+
+// DeleteBucket mocks base method.
+func (m *MockObjectStorageService) DeleteBucket(ctx context.Context, bucketName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBucket", ctx, bucketName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBucket indicates an expected call of DeleteBucket.
+func (mr *MockObjectStorageServiceMockRecorder) DeleteBucket(ctx, bucketName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucket", reflect.TypeOf((*MockObjectStorageService)(nil).DeleteBucket), ctx, bucketName)
+}
+
+// DeleteS3UserKey mocks base method.
+func (m *MockObjectStorageService) DeleteS3UserKey(ctx context.Context, accessKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteS3UserKey", ctx, accessKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteS3UserKey indicates an expected call of DeleteS3UserKey.
+func (mr *MockObjectStorageServiceMockRecorder) DeleteS3UserKey(ctx, accessKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteS3UserKey", reflect.TypeOf((*MockObjectStorageService)(nil).DeleteS3UserKey), ctx, accessKey)
+}
+
+// GenerateS3UserKey mocks base method.
+func (m *MockObjectStorageService) GenerateS3UserKey(ctx context.Context) (*[]objectstorage.S3Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateS3UserKey", ctx)
+	ret0, _ := ret[0].(*[]objectstorage.S3Credential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateS3UserKey indicates an expected call of GenerateS3UserKey.
+func (mr *MockObjectStorageServiceMockRecorder) GenerateS3UserKey(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateS3UserKey", reflect.TypeOf((*MockObjectStorageService)(nil).GenerateS3UserKey), ctx)
+}
+
+// GetBucket mocks base method.
+func (m *MockObjectStorageService) GetBucket(ctx context.Context, bucketName string) (*objectstorage.S3Bucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBucket", ctx, bucketName)
+	ret0, _ := ret[0].(*objectstorage.S3Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBucket indicates an expected call of GetBucket.
+func (mr *MockObjectStorageServiceMockRecorder) GetBucket(ctx, bucketName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucket", reflect.TypeOf((*MockObjectStorageService)(nil).GetBucket), ctx, bucketName)
+}
+
+// GetS3ApiURL mocks base method.
+func (m *MockObjectStorageService) GetS3ApiURL(ctx context.Context) (*map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetS3ApiURL", ctx)
+	ret0, _ := ret[0].(*map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetS3ApiURL indicates an expected call of GetS3ApiURL.
+func (mr *MockObjectStorageServiceMockRecorder) GetS3ApiURL(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetS3ApiURL", reflect.TypeOf((*MockObjectStorageService)(nil).GetS3ApiURL), ctx)
+}
+
+// GetS3UserInfo mocks base method.
+func (m *MockObjectStorageService) GetS3UserInfo(ctx context.Context) (*objectstorage.S3UserInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetS3UserInfo", ctx)
+	ret0, _ := ret[0].(*objectstorage.S3UserInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetS3UserInfo indicates an expected call of GetS3UserInfo.
+func (mr *MockObjectStorageServiceMockRecorder) GetS3UserInfo(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetS3UserInfo", reflect.TypeOf((*MockObjectStorageService)(nil).GetS3UserInfo), ctx)
+}
+
+// GetS3UserKeys mocks base method.
+func (m *MockObjectStorageService) GetS3UserKeys(ctx context.Context) (*[]objectstorage.S3Credential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetS3UserKeys", ctx)
+	ret0, _ := ret[0].(*[]objectstorage.S3Credential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetS3UserKeys indicates an expected call of GetS3UserKeys.
+func (mr *MockObjectStorageServiceMockRecorder) GetS3UserKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetS3UserKeys", reflect.TypeOf((*MockObjectStorageService)(nil).GetS3UserKeys), ctx)
+}
+
+// ListBuckets mocks base method.
+func (m *MockObjectStorageService) ListBuckets(ctx context.Context) (*[]objectstorage.S3Bucket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBuckets", ctx)
+	ret0, _ := ret[0].(*[]objectstorage.S3Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBuckets indicates an expected call of ListBuckets.
+func (mr *MockObjectStorageServiceMockRecorder) ListBuckets(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuckets", reflect.TypeOf((*MockObjectStorageService)(nil).ListBuckets), ctx)
+}
+
+// ListBucketsIterator mocks base method.
+func (m *MockObjectStorageService) ListBucketsIterator(limit int) *api.Iterator[objectstorage.S3Bucket] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBucketsIterator", limit)
+	ret0, _ := ret[0].(*api.Iterator[objectstorage.S3Bucket])
+	return ret0
+}
+
+// ListBucketsIterator indicates an expected call of ListBucketsIterator.
+func (mr *MockObjectStorageServiceMockRecorder) ListBucketsIterator(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBucketsIterator", reflect.TypeOf((*MockObjectStorageService)(nil).ListBucketsIterator), limit)
+}
+
+// UpdateBucketBillingAccount mocks base method.
+func (m *MockObjectStorageService) UpdateBucketBillingAccount(ctx context.Context, bucketName string, billingAccountID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBucketBillingAccount", ctx, bucketName, billingAccountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBucketBillingAccount indicates an expected call of UpdateBucketBillingAccount.
+func (mr *MockObjectStorageServiceMockRecorder) UpdateBucketBillingAccount(ctx, bucketName, billingAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBucketBillingAccount", reflect.TypeOf((*MockObjectStorageService)(nil).UpdateBucketBillingAccount), ctx, bucketName, billingAccountID)
+}
+
+// MockDiskService is a mock of DiskService interface.
+type MockDiskService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiskServiceMockRecorder
+}
+
+// MockDiskServiceMockRecorder is the mock recorder for MockDiskService.
+type MockDiskServiceMockRecorder struct {
+	mock *MockDiskService
+}
+
+// NewMockDiskService creates a new mock instance.
+func NewMockDiskService(ctrl *gomock.Controller) *MockDiskService {
+	mock := &MockDiskService{ctrl: ctrl}
+	mock.recorder = &MockDiskServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiskService) EXPECT() *MockDiskServiceMockRecorder {
+	return m.recorder
+}
+
+// AttachDiskToVM mocks base method.
+func (m *MockDiskService) AttachDiskToVM(ctx context.Context, diskID, vmID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachDiskToVM", ctx, diskID, vmID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachDiskToVM indicates an expected call of AttachDiskToVM.
+func (mr *MockDiskServiceMockRecorder) AttachDiskToVM(ctx, diskID, vmID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachDiskToVM", reflect.TypeOf((*MockDiskService)(nil).AttachDiskToVM), ctx, diskID, vmID)
+}
+
+// CloneDisk mocks base method.
+func (m *MockDiskService) CloneDisk(ctx context.Context, sourceDiskID uuid.UUID, cfg blockstorage.CreateDiskConfig) (*blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneDisk", ctx, sourceDiskID, cfg)
+	ret0, _ := ret[0].(*blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneDisk indicates an expected call of CloneDisk.
+func (mr *MockDiskServiceMockRecorder) CloneDisk(ctx, sourceDiskID, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneDisk", reflect.TypeOf((*MockDiskService)(nil).CloneDisk), ctx, sourceDiskID, cfg)
+}
+
+// CreateDisk mocks base method.
+func (m *MockDiskService) CreateDisk(ctx context.Context, cfg blockstorage.CreateDiskConfig) (*blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDisk", ctx, cfg)
+	ret0, _ := ret[0].(*blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDisk indicates an expected call of CreateDisk.
+func (mr *MockDiskServiceMockRecorder) CreateDisk(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDisk", reflect.TypeOf((*MockDiskService)(nil).CreateDisk), ctx, cfg)
+}
+
+// CreateDiskFromSnapshot mocks base method.
+func (m *MockDiskService) CreateDiskFromSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID, cfg blockstorage.CreateDiskConfig) (*blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDiskFromSnapshot", ctx, diskID, snapshotID, cfg)
+	ret0, _ := ret[0].(*blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDiskFromSnapshot indicates an expected call of CreateDiskFromSnapshot.
+func (mr *MockDiskServiceMockRecorder) CreateDiskFromSnapshot(ctx, diskID, snapshotID, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiskFromSnapshot", reflect.TypeOf((*MockDiskService)(nil).CreateDiskFromSnapshot), ctx, diskID, snapshotID, cfg)
+}
+
+// CreateSnapshot mocks base method.
+func (m *MockDiskService) CreateSnapshot(ctx context.Context, diskID uuid.UUID) (*blockstorage.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshot", ctx, diskID)
+	ret0, _ := ret[0].(*blockstorage.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshot indicates an expected call of CreateSnapshot.
+func (mr *MockDiskServiceMockRecorder) CreateSnapshot(ctx, diskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockDiskService)(nil).CreateSnapshot), ctx, diskID)
+}
+
+// DeleteDisk mocks base method.
+func (m *MockDiskService) DeleteDisk(ctx context.Context, diskID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDisk", ctx, diskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDisk indicates an expected call of DeleteDisk.
+func (mr *MockDiskServiceMockRecorder) DeleteDisk(ctx, diskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDisk", reflect.TypeOf((*MockDiskService)(nil).DeleteDisk), ctx, diskID)
+}
+
+// DeleteDisks mocks base method.
+func (m *MockDiskService) DeleteDisks(ctx context.Context, diskIDs []uuid.UUID, opts bulk.Options) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDisks", ctx, diskIDs, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDisks indicates an expected call of DeleteDisks.
+func (mr *MockDiskServiceMockRecorder) DeleteDisks(ctx, diskIDs, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDisks", reflect.TypeOf((*MockDiskService)(nil).DeleteDisks), ctx, diskIDs, opts)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockDiskService) DeleteSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", ctx, diskID, snapshotID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockDiskServiceMockRecorder) DeleteSnapshot(ctx, diskID, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockDiskService)(nil).DeleteSnapshot), ctx, diskID, snapshotID)
+}
+
+// DetachDiskFromVM mocks base method.
+func (m *MockDiskService) DetachDiskFromVM(ctx context.Context, diskID, vmID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachDiskFromVM", ctx, diskID, vmID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachDiskFromVM indicates an expected call of DetachDiskFromVM.
+func (mr *MockDiskServiceMockRecorder) DetachDiskFromVM(ctx, diskID, vmID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachDiskFromVM", reflect.TypeOf((*MockDiskService)(nil).DetachDiskFromVM), ctx, diskID, vmID)
+}
+
+// GetDisk mocks base method.
+func (m *MockDiskService) GetDisk(ctx context.Context, diskID uuid.UUID) (*blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDisk", ctx, diskID)
+	ret0, _ := ret[0].(*blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDisk indicates an expected call of GetDisk.
+func (mr *MockDiskServiceMockRecorder) GetDisk(ctx, diskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDisk", reflect.TypeOf((*MockDiskService)(nil).GetDisk), ctx, diskID)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockDiskService) GetSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID) (*blockstorage.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", ctx, diskID, snapshotID)
+	ret0, _ := ret[0].(*blockstorage.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockDiskServiceMockRecorder) GetSnapshot(ctx, diskID, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockDiskService)(nil).GetSnapshot), ctx, diskID, snapshotID)
+}
+
+// ListDisks mocks base method.
+func (m *MockDiskService) ListDisks(ctx context.Context, opts blockstorage.ListDisksOptions) (*[]blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisks", ctx, opts)
+	ret0, _ := ret[0].(*[]blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDisks indicates an expected call of ListDisks.
+func (mr *MockDiskServiceMockRecorder) ListDisks(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisks", reflect.TypeOf((*MockDiskService)(nil).ListDisks), ctx, opts)
+}
+
+// ListDisksIterator mocks base method.
+func (m *MockDiskService) ListDisksIterator(limit int) *api.Iterator[blockstorage.Disk] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDisksIterator", limit)
+	ret0, _ := ret[0].(*api.Iterator[blockstorage.Disk])
+	return ret0
+}
+
+// ListDisksIterator indicates an expected call of ListDisksIterator.
+func (mr *MockDiskServiceMockRecorder) ListDisksIterator(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisksIterator", reflect.TypeOf((*MockDiskService)(nil).ListDisksIterator), limit)
+}
+
+// ListSnapshots mocks base method.
+func (m *MockDiskService) ListSnapshots(ctx context.Context, diskID uuid.UUID) (*[]blockstorage.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", ctx, diskID)
+	ret0, _ := ret[0].(*[]blockstorage.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockDiskServiceMockRecorder) ListSnapshots(ctx, diskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockDiskService)(nil).ListSnapshots), ctx, diskID)
+}
+
+// ResizeDisk mocks base method.
+func (m *MockDiskService) ResizeDisk(ctx context.Context, diskID uuid.UUID, newSizeGB int) (*blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeDisk", ctx, diskID, newSizeGB)
+	ret0, _ := ret[0].(*blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeDisk indicates an expected call of ResizeDisk.
+func (mr *MockDiskServiceMockRecorder) ResizeDisk(ctx, diskID, newSizeGB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeDisk", reflect.TypeOf((*MockDiskService)(nil).ResizeDisk), ctx, diskID, newSizeGB)
+}
+
+// RestoreSnapshot mocks base method.
+func (m *MockDiskService) RestoreSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID) (*blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSnapshot", ctx, diskID, snapshotID)
+	ret0, _ := ret[0].(*blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot.
+func (mr *MockDiskServiceMockRecorder) RestoreSnapshot(ctx, diskID, snapshotID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockDiskService)(nil).RestoreSnapshot), ctx, diskID, snapshotID)
+}
+
+// UpdateDisk mocks base method.
+func (m *MockDiskService) UpdateDisk(ctx context.Context, diskID uuid.UUID, cfg blockstorage.UpdateDiskConfig) (*blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDisk", ctx, diskID, cfg)
+	ret0, _ := ret[0].(*blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDisk indicates an expected call of UpdateDisk.
+func (mr *MockDiskServiceMockRecorder) UpdateDisk(ctx, diskID, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDisk", reflect.TypeOf((*MockDiskService)(nil).UpdateDisk), ctx, diskID, cfg)
+}
+
+// UpdateDiskBillingAccount mocks base method.
+func (m *MockDiskService) UpdateDiskBillingAccount(ctx context.Context, diskID uuid.UUID, billingAccountID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDiskBillingAccount", ctx, diskID, billingAccountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDiskBillingAccount indicates an expected call of UpdateDiskBillingAccount.
+func (mr *MockDiskServiceMockRecorder) UpdateDiskBillingAccount(ctx, diskID, billingAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDiskBillingAccount", reflect.TypeOf((*MockDiskService)(nil).UpdateDiskBillingAccount), ctx, diskID, billingAccountID)
+}
+
+// WaitForDiskStatus mocks base method.
+func (m *MockDiskService) WaitForDiskStatus(ctx context.Context, diskID uuid.UUID, status string, opts ...waiter.Option) (*blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, diskID, status}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForDiskStatus", varargs...)
+	ret0, _ := ret[0].(*blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForDiskStatus indicates an expected call of WaitForDiskStatus.
+func (mr *MockDiskServiceMockRecorder) WaitForDiskStatus(ctx, diskID, status any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, diskID, status}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForDiskStatus", reflect.TypeOf((*MockDiskService)(nil).WaitForDiskStatus), varargs...)
+}
+
+// WaitForSnapshot mocks base method.
+func (m *MockDiskService) WaitForSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID, opts ...waiter.Option) (*blockstorage.Snapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, diskID, snapshotID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForSnapshot", varargs...)
+	ret0, _ := ret[0].(*blockstorage.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForSnapshot indicates an expected call of WaitForSnapshot.
+func (mr *MockDiskServiceMockRecorder) WaitForSnapshot(ctx, diskID, snapshotID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, diskID, snapshotID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForSnapshot", reflect.TypeOf((*MockDiskService)(nil).WaitForSnapshot), varargs...)
+}
+
+// MockVPCService is a mock of VPCService interface.
+type MockVPCService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVPCServiceMockRecorder
+}
+
+// MockVPCServiceMockRecorder is the mock recorder for MockVPCService.
+type MockVPCServiceMockRecorder struct {
+	mock *MockVPCService
+}
+
+// NewMockVPCService creates a new mock instance.
+func NewMockVPCService(ctrl *gomock.Controller) *MockVPCService {
+	mock := &MockVPCService{ctrl: ctrl}
+	mock.recorder = &MockVPCServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVPCService) EXPECT() *MockVPCServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteNetwork mocks base method.
+func (m *MockVPCService) DeleteNetwork(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetwork", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetwork indicates an expected call of DeleteNetwork.
+func (mr *MockVPCServiceMockRecorder) DeleteNetwork(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockVPCService)(nil).DeleteNetwork), ctx, id)
+}
+
+// GetNetwork mocks base method.
+func (m *MockVPCService) GetNetwork(ctx context.Context, id uuid.UUID) (*vpc.NetworkInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetwork", ctx, id)
+	ret0, _ := ret[0].(*vpc.NetworkInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetwork indicates an expected call of GetNetwork.
+func (mr *MockVPCServiceMockRecorder) GetNetwork(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetwork", reflect.TypeOf((*MockVPCService)(nil).GetNetwork), ctx, id)
+}
+
+// GetOrCreateDefaultNetwork mocks base method.
+func (m *MockVPCService) GetOrCreateDefaultNetwork(ctx context.Context, name string) (*vpc.NetworkInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreateDefaultNetwork", ctx, name)
+	ret0, _ := ret[0].(*vpc.NetworkInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrCreateDefaultNetwork indicates an expected call of GetOrCreateDefaultNetwork.
+func (mr *MockVPCServiceMockRecorder) GetOrCreateDefaultNetwork(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateDefaultNetwork", reflect.TypeOf((*MockVPCService)(nil).GetOrCreateDefaultNetwork), ctx, name)
+}
+
+// ListIPAllocations mocks base method.
+func (m *MockVPCService) ListIPAllocations(ctx context.Context, id uuid.UUID) (*[]vpc.IPAllocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIPAllocations", ctx, id)
+	ret0, _ := ret[0].(*[]vpc.IPAllocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIPAllocations indicates an expected call of ListIPAllocations.
+func (mr *MockVPCServiceMockRecorder) ListIPAllocations(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIPAllocations", reflect.TypeOf((*MockVPCService)(nil).ListIPAllocations), ctx, id)
+}
+
+// ListNetworks mocks base method.
+func (m *MockVPCService) ListNetworks(ctx context.Context) (*[]vpc.NetworkInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworks", ctx)
+	ret0, _ := ret[0].(*[]vpc.NetworkInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworks indicates an expected call of ListNetworks.
+func (mr *MockVPCServiceMockRecorder) ListNetworks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworks", reflect.TypeOf((*MockVPCService)(nil).ListNetworks), ctx)
+}
+
+// ListNetworksIterator mocks base method.
+func (m *MockVPCService) ListNetworksIterator(limit int) *api.Iterator[vpc.NetworkInfo] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworksIterator", limit)
+	ret0, _ := ret[0].(*api.Iterator[vpc.NetworkInfo])
+	return ret0
+}
+
+// ListNetworksIterator indicates an expected call of ListNetworksIterator.
+func (mr *MockVPCServiceMockRecorder) ListNetworksIterator(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworksIterator", reflect.TypeOf((*MockVPCService)(nil).ListNetworksIterator), limit)
+}
+
+// ReleasePrivateIP mocks base method.
+func (m *MockVPCService) ReleasePrivateIP(ctx context.Context, id uuid.UUID, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePrivateIP", ctx, id, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePrivateIP indicates an expected call of ReleasePrivateIP.
+func (mr *MockVPCServiceMockRecorder) ReleasePrivateIP(ctx, id, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePrivateIP", reflect.TypeOf((*MockVPCService)(nil).ReleasePrivateIP), ctx, id, address)
+}
+
+// RenameNetwork mocks base method.
+func (m *MockVPCService) RenameNetwork(ctx context.Context, id uuid.UUID, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNetwork", ctx, id, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameNetwork indicates an expected call of RenameNetwork.
+func (mr *MockVPCServiceMockRecorder) RenameNetwork(ctx, id, newName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNetwork", reflect.TypeOf((*MockVPCService)(nil).RenameNetwork), ctx, id, newName)
+}
+
+// ReservePrivateIP mocks base method.
+func (m *MockVPCService) ReservePrivateIP(ctx context.Context, id uuid.UUID, address string) (*vpc.IPAllocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReservePrivateIP", ctx, id, address)
+	ret0, _ := ret[0].(*vpc.IPAllocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReservePrivateIP indicates an expected call of ReservePrivateIP.
+func (mr *MockVPCServiceMockRecorder) ReservePrivateIP(ctx, id, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReservePrivateIP", reflect.TypeOf((*MockVPCService)(nil).ReservePrivateIP), ctx, id, address)
+}
+
+// SetDefaultNetwork mocks base method.
+func (m *MockVPCService) SetDefaultNetwork(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultNetwork", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultNetwork indicates an expected call of SetDefaultNetwork.
+func (mr *MockVPCServiceMockRecorder) SetDefaultNetwork(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultNetwork", reflect.TypeOf((*MockVPCService)(nil).SetDefaultNetwork), ctx, id)
+}
+
+// MockFloatingIPService is a mock of FloatingIPService interface.
+type MockFloatingIPService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFloatingIPServiceMockRecorder
+}
+
+// MockFloatingIPServiceMockRecorder is the mock recorder for MockFloatingIPService.
+type MockFloatingIPServiceMockRecorder struct {
+	mock *MockFloatingIPService
+}
+
+// NewMockFloatingIPService creates a new mock instance.
+func NewMockFloatingIPService(ctrl *gomock.Controller) *MockFloatingIPService {
+	mock := &MockFloatingIPService{ctrl: ctrl}
+	mock.recorder = &MockFloatingIPServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFloatingIPService) EXPECT() *MockFloatingIPServiceMockRecorder {
+	return m.recorder
+}
+
+// AssignFloatingIPToVM mocks base method.
+func (m *MockFloatingIPService) AssignFloatingIPToVM(ctx context.Context, address string, vmUUID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignFloatingIPToVM", ctx, address, vmUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignFloatingIPToVM indicates an expected call of AssignFloatingIPToVM.
+func (mr *MockFloatingIPServiceMockRecorder) AssignFloatingIPToVM(ctx, address, vmUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignFloatingIPToVM", reflect.TypeOf((*MockFloatingIPService)(nil).AssignFloatingIPToVM), ctx, address, vmUUID)
+}
+
+// CreateFloatingIP mocks base method.
+func (m *MockFloatingIPService) CreateFloatingIP(ctx context.Context, info *ip.IPAddressInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFloatingIP", ctx, info)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFloatingIP indicates an expected call of CreateFloatingIP.
+func (mr *MockFloatingIPServiceMockRecorder) CreateFloatingIP(ctx, info any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFloatingIP", reflect.TypeOf((*MockFloatingIPService)(nil).CreateFloatingIP), ctx, info)
+}
+
+// DeleteFloatingIP mocks base method.
+func (m *MockFloatingIPService) DeleteFloatingIP(ctx context.Context, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFloatingIP", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFloatingIP indicates an expected call of DeleteFloatingIP.
+func (mr *MockFloatingIPServiceMockRecorder) DeleteFloatingIP(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFloatingIP", reflect.TypeOf((*MockFloatingIPService)(nil).DeleteFloatingIP), ctx, address)
+}
+
+// GetFloatingIP mocks base method.
+func (m *MockFloatingIPService) GetFloatingIP(ctx context.Context, address string) (*ip.IPAddressInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloatingIP", ctx, address)
+	ret0, _ := ret[0].(*ip.IPAddressInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloatingIP indicates an expected call of GetFloatingIP.
+func (mr *MockFloatingIPServiceMockRecorder) GetFloatingIP(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloatingIP", reflect.TypeOf((*MockFloatingIPService)(nil).GetFloatingIP), ctx, address)
+}
+
+// ListFloatingIPs mocks base method.
+func (m *MockFloatingIPService) ListFloatingIPs(ctx context.Context) (*[]ip.IPAddressInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloatingIPs", ctx)
+	ret0, _ := ret[0].(*[]ip.IPAddressInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFloatingIPs indicates an expected call of ListFloatingIPs.
+func (mr *MockFloatingIPServiceMockRecorder) ListFloatingIPs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloatingIPs", reflect.TypeOf((*MockFloatingIPService)(nil).ListFloatingIPs), ctx)
+}
+
+// ListFloatingIPsIterator mocks base method.
+func (m *MockFloatingIPService) ListFloatingIPsIterator(limit int) *api.Iterator[ip.IPAddressInfo] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFloatingIPsIterator", limit)
+	ret0, _ := ret[0].(*api.Iterator[ip.IPAddressInfo])
+	return ret0
+}
+
+// ListFloatingIPsIterator indicates an expected call of ListFloatingIPsIterator.
+func (mr *MockFloatingIPServiceMockRecorder) ListFloatingIPsIterator(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFloatingIPsIterator", reflect.TypeOf((*MockFloatingIPService)(nil).ListFloatingIPsIterator), limit)
+}
+
+// SetFloatingIPReverseDNS mocks base method.
+func (m *MockFloatingIPService) SetFloatingIPReverseDNS(ctx context.Context, address, hostname string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFloatingIPReverseDNS", ctx, address, hostname)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFloatingIPReverseDNS indicates an expected call of SetFloatingIPReverseDNS.
+func (mr *MockFloatingIPServiceMockRecorder) SetFloatingIPReverseDNS(ctx, address, hostname any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFloatingIPReverseDNS", reflect.TypeOf((*MockFloatingIPService)(nil).SetFloatingIPReverseDNS), ctx, address, hostname)
+}
+
+// UnassignFloatingIPFromVM mocks base method.
+func (m *MockFloatingIPService) UnassignFloatingIPFromVM(ctx context.Context, address string, vmUUID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignFloatingIPFromVM", ctx, address, vmUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignFloatingIPFromVM indicates an expected call of UnassignFloatingIPFromVM.
+func (mr *MockFloatingIPServiceMockRecorder) UnassignFloatingIPFromVM(ctx, address, vmUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignFloatingIPFromVM", reflect.TypeOf((*MockFloatingIPService)(nil).UnassignFloatingIPFromVM), ctx, address, vmUUID)
+}
+
+// UpdateFloatingIP mocks base method.
+func (m *MockFloatingIPService) UpdateFloatingIP(ctx context.Context, info *ip.IPAddressInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFloatingIP", ctx, info)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFloatingIP indicates an expected call of UpdateFloatingIP.
+func (mr *MockFloatingIPServiceMockRecorder) UpdateFloatingIP(ctx, info any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFloatingIP", reflect.TypeOf((*MockFloatingIPService)(nil).UpdateFloatingIP), ctx, info)
+}
+
+// UpdateFloatingIPBillingAccount mocks base method.
+func (m *MockFloatingIPService) UpdateFloatingIPBillingAccount(ctx context.Context, address string, billingAccountID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFloatingIPBillingAccount", ctx, address, billingAccountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFloatingIPBillingAccount indicates an expected call of UpdateFloatingIPBillingAccount.
+func (mr *MockFloatingIPServiceMockRecorder) UpdateFloatingIPBillingAccount(ctx, address, billingAccountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFloatingIPBillingAccount", reflect.TypeOf((*MockFloatingIPService)(nil).UpdateFloatingIPBillingAccount), ctx, address, billingAccountID)
+}
+
+// MockVMService is a mock of VMService interface.
+type MockVMService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVMServiceMockRecorder
+}
+
+// MockVMServiceMockRecorder is the mock recorder for MockVMService.
+type MockVMServiceMockRecorder struct {
+	mock *MockVMService
+}
+
+// NewMockVMService creates a new mock instance.
+func NewMockVMService(ctrl *gomock.Controller) *MockVMService {
+	mock := &MockVMService{ctrl: ctrl}
+	mock.recorder = &MockVMServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVMService) EXPECT() *MockVMServiceMockRecorder {
+	return m.recorder
+}
+
+// AttachDisk mocks base method.
+func (m *MockVMService) AttachDisk(ctx context.Context, id, diskID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachDisk", ctx, id, diskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachDisk indicates an expected call of AttachDisk.
+func (mr *MockVMServiceMockRecorder) AttachDisk(ctx, id, diskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachDisk", reflect.TypeOf((*MockVMService)(nil).AttachDisk), ctx, id, diskID)
+}
+
+// AttachDiskAndWait mocks base method.
+func (m *MockVMService) AttachDiskAndWait(ctx context.Context, id, diskID uuid.UUID, opts ...waiter.Option) (*vm.Attachment, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, diskID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AttachDiskAndWait", varargs...)
+	ret0, _ := ret[0].(*vm.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachDiskAndWait indicates an expected call of AttachDiskAndWait.
+func (mr *MockVMServiceMockRecorder) AttachDiskAndWait(ctx, id, diskID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, diskID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachDiskAndWait", reflect.TypeOf((*MockVMService)(nil).AttachDiskAndWait), varargs...)
+}
+
+// AttachISO mocks base method.
+func (m *MockVMService) AttachISO(ctx context.Context, id uuid.UUID, isoID string) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachISO", ctx, id, isoID)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachISO indicates an expected call of AttachISO.
+func (mr *MockVMServiceMockRecorder) AttachISO(ctx, id, isoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachISO", reflect.TypeOf((*MockVMService)(nil).AttachISO), ctx, id, isoID)
+}
+
+// AttachVMToNetwork mocks base method.
+func (m *MockVMService) AttachVMToNetwork(ctx context.Context, id, networkID uuid.UUID, privateIPv4 string) (*vm.NetworkInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachVMToNetwork", ctx, id, networkID, privateIPv4)
+	ret0, _ := ret[0].(*vm.NetworkInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachVMToNetwork indicates an expected call of AttachVMToNetwork.
+func (mr *MockVMServiceMockRecorder) AttachVMToNetwork(ctx, id, networkID, privateIPv4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVMToNetwork", reflect.TypeOf((*MockVMService)(nil).AttachVMToNetwork), ctx, id, networkID, privateIPv4)
+}
+
+// CloneVM mocks base method.
+func (m *MockVMService) CloneVM(ctx context.Context, id uuid.UUID, newName string) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneVM", ctx, id, newName)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneVM indicates an expected call of CloneVM.
+func (mr *MockVMServiceMockRecorder) CloneVM(ctx, id, newName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneVM", reflect.TypeOf((*MockVMService)(nil).CloneVM), ctx, id, newName)
+}
+
+// CreateImageFromVM mocks base method.
+func (m *MockVMService) CreateImageFromVM(ctx context.Context, id uuid.UUID, name string) (*image.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImageFromVM", ctx, id, name)
+	ret0, _ := ret[0].(*image.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImageFromVM indicates an expected call of CreateImageFromVM.
+func (mr *MockVMServiceMockRecorder) CreateImageFromVM(ctx, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImageFromVM", reflect.TypeOf((*MockVMService)(nil).CreateImageFromVM), ctx, id, name)
+}
+
+// CreateVM mocks base method.
+func (m *MockVMService) CreateVM(ctx context.Context, cfg vm.CreateVMConfig) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVM", ctx, cfg)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVM indicates an expected call of CreateVM.
+func (mr *MockVMServiceMockRecorder) CreateVM(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVM", reflect.TypeOf((*MockVMService)(nil).CreateVM), ctx, cfg)
+}
+
+// CreateVMWithFloatingIP mocks base method.
+func (m *MockVMService) CreateVMWithFloatingIP(ctx context.Context, cfg vm.CreateVMConfig, address string, opts ...waiter.Option) (*vm.VM, *ip.IPAddressInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, cfg, address}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateVMWithFloatingIP", varargs...)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(*ip.IPAddressInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVMWithFloatingIP indicates an expected call of CreateVMWithFloatingIP.
+func (mr *MockVMServiceMockRecorder) CreateVMWithFloatingIP(ctx, cfg, address any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, cfg, address}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMWithFloatingIP", reflect.TypeOf((*MockVMService)(nil).CreateVMWithFloatingIP), varargs...)
+}
+
+// DeleteVM mocks base method.
+func (m *MockVMService) DeleteVM(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVM", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVM indicates an expected call of DeleteVM.
+func (mr *MockVMServiceMockRecorder) DeleteVM(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVM", reflect.TypeOf((*MockVMService)(nil).DeleteVM), ctx, id)
+}
+
+// DeleteVMBackup mocks base method.
+func (m *MockVMService) DeleteVMBackup(ctx context.Context, id, backupID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVMBackup", ctx, id, backupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVMBackup indicates an expected call of DeleteVMBackup.
+func (mr *MockVMServiceMockRecorder) DeleteVMBackup(ctx, id, backupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMBackup", reflect.TypeOf((*MockVMService)(nil).DeleteVMBackup), ctx, id, backupID)
+}
+
+// DeleteVMWithOptions mocks base method.
+func (m *MockVMService) DeleteVMWithOptions(ctx context.Context, id uuid.UUID, opts vm.DeleteVMOptions, waitOpts ...waiter.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, opts}
+	for _, a := range waitOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteVMWithOptions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVMWithOptions indicates an expected call of DeleteVMWithOptions.
+func (mr *MockVMServiceMockRecorder) DeleteVMWithOptions(ctx, id, opts any, waitOpts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, opts}, waitOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMWithOptions", reflect.TypeOf((*MockVMService)(nil).DeleteVMWithOptions), varargs...)
+}
+
+// DeleteVMs mocks base method.
+func (m *MockVMService) DeleteVMs(ctx context.Context, ids []uuid.UUID, opts bulk.Options) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVMs", ctx, ids, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVMs indicates an expected call of DeleteVMs.
+func (mr *MockVMServiceMockRecorder) DeleteVMs(ctx, ids, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMs", reflect.TypeOf((*MockVMService)(nil).DeleteVMs), ctx, ids, opts)
+}
+
+// DetachDisk mocks base method.
+func (m *MockVMService) DetachDisk(ctx context.Context, id, diskID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachDisk", ctx, id, diskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachDisk indicates an expected call of DetachDisk.
+func (mr *MockVMServiceMockRecorder) DetachDisk(ctx, id, diskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachDisk", reflect.TypeOf((*MockVMService)(nil).DetachDisk), ctx, id, diskID)
+}
+
+// DetachDiskAndWait mocks base method.
+func (m *MockVMService) DetachDiskAndWait(ctx context.Context, id, diskID uuid.UUID, opts ...waiter.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, diskID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DetachDiskAndWait", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachDiskAndWait indicates an expected call of DetachDiskAndWait.
+func (mr *MockVMServiceMockRecorder) DetachDiskAndWait(ctx, id, diskID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, diskID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachDiskAndWait", reflect.TypeOf((*MockVMService)(nil).DetachDiskAndWait), varargs...)
+}
+
+// DetachISO mocks base method.
+func (m *MockVMService) DetachISO(ctx context.Context, id uuid.UUID) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachISO", ctx, id)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachISO indicates an expected call of DetachISO.
+func (mr *MockVMServiceMockRecorder) DetachISO(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachISO", reflect.TypeOf((*MockVMService)(nil).DetachISO), ctx, id)
+}
+
+// DetachVMFromNetwork mocks base method.
+func (m *MockVMService) DetachVMFromNetwork(ctx context.Context, id, networkID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVMFromNetwork", ctx, id, networkID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachVMFromNetwork indicates an expected call of DetachVMFromNetwork.
+func (mr *MockVMServiceMockRecorder) DetachVMFromNetwork(ctx, id, networkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVMFromNetwork", reflect.TypeOf((*MockVMService)(nil).DetachVMFromNetwork), ctx, id, networkID)
+}
+
+// DisableVMBackup mocks base method.
+func (m *MockVMService) DisableVMBackup(ctx context.Context, id uuid.UUID) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableVMBackup", ctx, id)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableVMBackup indicates an expected call of DisableVMBackup.
+func (mr *MockVMServiceMockRecorder) DisableVMBackup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableVMBackup", reflect.TypeOf((*MockVMService)(nil).DisableVMBackup), ctx, id)
+}
+
+// EnableVMBackup mocks base method.
+func (m *MockVMService) EnableVMBackup(ctx context.Context, id uuid.UUID) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableVMBackup", ctx, id)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableVMBackup indicates an expected call of EnableVMBackup.
+func (mr *MockVMServiceMockRecorder) EnableVMBackup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableVMBackup", reflect.TypeOf((*MockVMService)(nil).EnableVMBackup), ctx, id)
+}
+
+// GetTags mocks base method.
+func (m *MockVMService) GetTags(ctx context.Context, id uuid.UUID) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTags", ctx, id)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTags indicates an expected call of GetTags.
+func (mr *MockVMServiceMockRecorder) GetTags(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTags", reflect.TypeOf((*MockVMService)(nil).GetTags), ctx, id)
+}
+
+// GetVM mocks base method.
+func (m *MockVMService) GetVM(ctx context.Context, id uuid.UUID) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVM", ctx, id)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVM indicates an expected call of GetVM.
+func (mr *MockVMServiceMockRecorder) GetVM(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVM", reflect.TypeOf((*MockVMService)(nil).GetVM), ctx, id)
+}
+
+// GetVMConsole mocks base method.
+func (m *MockVMService) GetVMConsole(ctx context.Context, id uuid.UUID) (*vm.Console, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVMConsole", ctx, id)
+	ret0, _ := ret[0].(*vm.Console)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVMConsole indicates an expected call of GetVMConsole.
+func (mr *MockVMServiceMockRecorder) GetVMConsole(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMConsole", reflect.TypeOf((*MockVMService)(nil).GetVMConsole), ctx, id)
+}
+
+// GetVMConsoleURL mocks base method.
+func (m *MockVMService) GetVMConsoleURL(ctx context.Context, id uuid.UUID) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVMConsoleURL", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVMConsoleURL indicates an expected call of GetVMConsoleURL.
+func (mr *MockVMServiceMockRecorder) GetVMConsoleURL(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMConsoleURL", reflect.TypeOf((*MockVMService)(nil).GetVMConsoleURL), ctx, id)
+}
+
+// GetVMMetrics mocks base method.
+func (m *MockVMService) GetVMMetrics(ctx context.Context, id uuid.UUID, opts vm.MetricsOptions) (*[]vm.MetricSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVMMetrics", ctx, id, opts)
+	ret0, _ := ret[0].(*[]vm.MetricSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVMMetrics indicates an expected call of GetVMMetrics.
+func (mr *MockVMServiceMockRecorder) GetVMMetrics(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMMetrics", reflect.TypeOf((*MockVMService)(nil).GetVMMetrics), ctx, id, opts)
+}
+
+// ListISOs mocks base method.
+func (m *MockVMService) ListISOs(ctx context.Context) (*[]vm.ISO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListISOs", ctx)
+	ret0, _ := ret[0].(*[]vm.ISO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListISOs indicates an expected call of ListISOs.
+func (mr *MockVMServiceMockRecorder) ListISOs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListISOs", reflect.TypeOf((*MockVMService)(nil).ListISOs), ctx)
+}
+
+// ListVMBackups mocks base method.
+func (m *MockVMService) ListVMBackups(ctx context.Context, id uuid.UUID) (*[]vm.Backup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVMBackups", ctx, id)
+	ret0, _ := ret[0].(*[]vm.Backup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMBackups indicates an expected call of ListVMBackups.
+func (mr *MockVMServiceMockRecorder) ListVMBackups(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMBackups", reflect.TypeOf((*MockVMService)(nil).ListVMBackups), ctx, id)
+}
+
+// ListVMDisks mocks base method.
+func (m *MockVMService) ListVMDisks(ctx context.Context, id uuid.UUID) (*[]blockstorage.Disk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVMDisks", ctx, id)
+	ret0, _ := ret[0].(*[]blockstorage.Disk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMDisks indicates an expected call of ListVMDisks.
+func (mr *MockVMServiceMockRecorder) ListVMDisks(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMDisks", reflect.TypeOf((*MockVMService)(nil).ListVMDisks), ctx, id)
+}
+
+// ListVMNetworkInterfaces mocks base method.
+func (m *MockVMService) ListVMNetworkInterfaces(ctx context.Context, id uuid.UUID) (*[]vm.NetworkInterface, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVMNetworkInterfaces", ctx, id)
+	ret0, _ := ret[0].(*[]vm.NetworkInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMNetworkInterfaces indicates an expected call of ListVMNetworkInterfaces.
+func (mr *MockVMServiceMockRecorder) ListVMNetworkInterfaces(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMNetworkInterfaces", reflect.TypeOf((*MockVMService)(nil).ListVMNetworkInterfaces), ctx, id)
+}
+
+// ListVMSummaries mocks base method.
+func (m *MockVMService) ListVMSummaries(ctx context.Context, opts vm.ListVMsOptions) (*[]vm.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVMSummaries", ctx, opts)
+	ret0, _ := ret[0].(*[]vm.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMSummaries indicates an expected call of ListVMSummaries.
+func (mr *MockVMServiceMockRecorder) ListVMSummaries(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSummaries", reflect.TypeOf((*MockVMService)(nil).ListVMSummaries), ctx, opts)
+}
+
+// ListVMs mocks base method.
+func (m *MockVMService) ListVMs(ctx context.Context) (*[]vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVMs", ctx)
+	ret0, _ := ret[0].(*[]vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMs indicates an expected call of ListVMs.
+func (mr *MockVMServiceMockRecorder) ListVMs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMs", reflect.TypeOf((*MockVMService)(nil).ListVMs), ctx)
+}
+
+// ListVMsIterator mocks base method.
+func (m *MockVMService) ListVMsIterator(limit int) *api.Iterator[vm.VM] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVMsIterator", limit)
+	ret0, _ := ret[0].(*api.Iterator[vm.VM])
+	return ret0
+}
+
+// ListVMsIterator indicates an expected call of ListVMsIterator.
+func (mr *MockVMServiceMockRecorder) ListVMsIterator(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMsIterator", reflect.TypeOf((*MockVMService)(nil).ListVMsIterator), limit)
+}
+
+// ModifyVM mocks base method.
+func (m *MockVMService) ModifyVM(ctx context.Context, id uuid.UUID, cfg vm.ModifyVMConfig) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyVM", ctx, id, cfg)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyVM indicates an expected call of ModifyVM.
+func (mr *MockVMServiceMockRecorder) ModifyVM(ctx, id, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVM", reflect.TypeOf((*MockVMService)(nil).ModifyVM), ctx, id, cfg)
+}
+
+// PowerOffVM mocks base method.
+func (m *MockVMService) PowerOffVM(ctx context.Context, id uuid.UUID) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerOffVM", ctx, id)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PowerOffVM indicates an expected call of PowerOffVM.
+func (mr *MockVMServiceMockRecorder) PowerOffVM(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerOffVM", reflect.TypeOf((*MockVMService)(nil).PowerOffVM), ctx, id)
+}
+
+// RebootVM mocks base method.
+func (m *MockVMService) RebootVM(ctx context.Context, id uuid.UUID) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootVM", ctx, id)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootVM indicates an expected call of RebootVM.
+func (mr *MockVMServiceMockRecorder) RebootVM(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVM", reflect.TypeOf((*MockVMService)(nil).RebootVM), ctx, id)
+}
+
+// RenameVM mocks base method.
+func (m *MockVMService) RenameVM(ctx context.Context, id uuid.UUID, name string) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameVM", ctx, id, name)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameVM indicates an expected call of RenameVM.
+func (mr *MockVMServiceMockRecorder) RenameVM(ctx, id, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameVM", reflect.TypeOf((*MockVMService)(nil).RenameVM), ctx, id, name)
+}
+
+// ResetVMPassword mocks base method.
+func (m *MockVMService) ResetVMPassword(ctx context.Context, id uuid.UUID, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetVMPassword", ctx, id, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetVMPassword indicates an expected call of ResetVMPassword.
+func (mr *MockVMServiceMockRecorder) ResetVMPassword(ctx, id, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVMPassword", reflect.TypeOf((*MockVMService)(nil).ResetVMPassword), ctx, id, newPassword)
+}
+
+// RestoreVMBackup mocks base method.
+func (m *MockVMService) RestoreVMBackup(ctx context.Context, id, backupID uuid.UUID) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVMBackup", ctx, id, backupID)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVMBackup indicates an expected call of RestoreVMBackup.
+func (mr *MockVMServiceMockRecorder) RestoreVMBackup(ctx, id, backupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVMBackup", reflect.TypeOf((*MockVMService)(nil).RestoreVMBackup), ctx, id, backupID)
+}
+
+// SetTags mocks base method.
+func (m *MockVMService) SetTags(ctx context.Context, id uuid.UUID, tags map[string]string) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTags", ctx, id, tags)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTags indicates an expected call of SetTags.
+func (mr *MockVMServiceMockRecorder) SetTags(ctx, id, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTags", reflect.TypeOf((*MockVMService)(nil).SetTags), ctx, id, tags)
+}
+
+// ShutdownVM mocks base method.
+func (m *MockVMService) ShutdownVM(ctx context.Context, id uuid.UUID, timeout time.Duration, opts ...waiter.Option) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, timeout}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ShutdownVM", varargs...)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShutdownVM indicates an expected call of ShutdownVM.
+func (mr *MockVMServiceMockRecorder) ShutdownVM(ctx, id, timeout any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, timeout}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShutdownVM", reflect.TypeOf((*MockVMService)(nil).ShutdownVM), varargs...)
+}
+
+// StartVM mocks base method.
+func (m *MockVMService) StartVM(ctx context.Context, id uuid.UUID) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVM", ctx, id)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVM indicates an expected call of StartVM.
+func (mr *MockVMServiceMockRecorder) StartVM(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVM", reflect.TypeOf((*MockVMService)(nil).StartVM), ctx, id)
+}
+
+// StopVM mocks base method.
+func (m *MockVMService) StopVM(ctx context.Context, id uuid.UUID) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopVM", ctx, id)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopVM indicates an expected call of StopVM.
+func (mr *MockVMServiceMockRecorder) StopVM(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopVM", reflect.TypeOf((*MockVMService)(nil).StopVM), ctx, id)
+}
+
+// UpdateVMDescription mocks base method.
+func (m *MockVMService) UpdateVMDescription(ctx context.Context, id uuid.UUID, description string) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVMDescription", ctx, id, description)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVMDescription indicates an expected call of UpdateVMDescription.
+func (mr *MockVMServiceMockRecorder) UpdateVMDescription(ctx, id, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMDescription", reflect.TypeOf((*MockVMService)(nil).UpdateVMDescription), ctx, id, description)
+}
+
+// UpdateVMMetadata mocks base method.
+func (m *MockVMService) UpdateVMMetadata(ctx context.Context, id uuid.UUID, metadata map[string]string) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVMMetadata", ctx, id, metadata)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVMMetadata indicates an expected call of UpdateVMMetadata.
+func (mr *MockVMServiceMockRecorder) UpdateVMMetadata(ctx, id, metadata any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMMetadata", reflect.TypeOf((*MockVMService)(nil).UpdateVMMetadata), ctx, id, metadata)
+}
+
+// WaitForVMDeleted mocks base method.
+func (m *MockVMService) WaitForVMDeleted(ctx context.Context, id uuid.UUID, opts ...waiter.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForVMDeleted", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForVMDeleted indicates an expected call of WaitForVMDeleted.
+func (mr *MockVMServiceMockRecorder) WaitForVMDeleted(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForVMDeleted", reflect.TypeOf((*MockVMService)(nil).WaitForVMDeleted), varargs...)
+}
+
+// WaitForVMRestore mocks base method.
+func (m *MockVMService) WaitForVMRestore(ctx context.Context, id uuid.UUID, opts ...waiter.Option) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForVMRestore", varargs...)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForVMRestore indicates an expected call of WaitForVMRestore.
+func (mr *MockVMServiceMockRecorder) WaitForVMRestore(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForVMRestore", reflect.TypeOf((*MockVMService)(nil).WaitForVMRestore), varargs...)
+}
+
+// WaitForVMStatus mocks base method.
+func (m *MockVMService) WaitForVMStatus(ctx context.Context, id uuid.UUID, status string, opts ...waiter.Option) (*vm.VM, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, status}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForVMStatus", varargs...)
+	ret0, _ := ret[0].(*vm.VM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForVMStatus indicates an expected call of WaitForVMStatus.
+func (mr *MockVMServiceMockRecorder) WaitForVMStatus(ctx, id, status any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, status}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForVMStatus", reflect.TypeOf((*MockVMService)(nil).WaitForVMStatus), varargs...)
+}
+
+// MockLoadBalancerService is a mock of LoadBalancerService interface.
+type MockLoadBalancerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadBalancerServiceMockRecorder
+}
+
+// MockLoadBalancerServiceMockRecorder is the mock recorder for MockLoadBalancerService.
+type MockLoadBalancerServiceMockRecorder struct {
+	mock *MockLoadBalancerService
+}
+
+// NewMockLoadBalancerService creates a new mock instance.
+func NewMockLoadBalancerService(ctrl *gomock.Controller) *MockLoadBalancerService {
+	mock := &MockLoadBalancerService{ctrl: ctrl}
+	mock.recorder = &MockLoadBalancerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadBalancerService) EXPECT() *MockLoadBalancerServiceMockRecorder {
+	return m.recorder
+}
+
+// AddForwardingRule mocks base method.
+func (m *MockLoadBalancerService) AddForwardingRule(ctx context.Context, id uuid.UUID, cfg lb.ForwardingRuleConfig) (*lb.ForwardingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddForwardingRule", ctx, id, cfg)
+	ret0, _ := ret[0].(*lb.ForwardingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddForwardingRule indicates an expected call of AddForwardingRule.
+func (mr *MockLoadBalancerServiceMockRecorder) AddForwardingRule(ctx, id, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddForwardingRule", reflect.TypeOf((*MockLoadBalancerService)(nil).AddForwardingRule), ctx, id, cfg)
+}
+
+// AddTarget mocks base method.
+func (m *MockLoadBalancerService) AddTarget(ctx context.Context, id uuid.UUID, cfg lb.TargetConfig) (*lb.Target, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTarget", ctx, id, cfg)
+	ret0, _ := ret[0].(*lb.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTarget indicates an expected call of AddTarget.
+func (mr *MockLoadBalancerServiceMockRecorder) AddTarget(ctx, id, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTarget", reflect.TypeOf((*MockLoadBalancerService)(nil).AddTarget), ctx, id, cfg)
+}
+
+// CreateLoadBalancer mocks base method.
+func (m *MockLoadBalancerService) CreateLoadBalancer(ctx context.Context, cfg lb.CreateLoadBalancerConfig) (*lb.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoadBalancer", ctx, cfg)
+	ret0, _ := ret[0].(*lb.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoadBalancer indicates an expected call of CreateLoadBalancer.
+func (mr *MockLoadBalancerServiceMockRecorder) CreateLoadBalancer(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancer", reflect.TypeOf((*MockLoadBalancerService)(nil).CreateLoadBalancer), ctx, cfg)
+}
+
+// DeleteForwardingRule mocks base method.
+func (m *MockLoadBalancerService) DeleteForwardingRule(ctx context.Context, id, ruleID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForwardingRule", ctx, id, ruleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteForwardingRule indicates an expected call of DeleteForwardingRule.
+func (mr *MockLoadBalancerServiceMockRecorder) DeleteForwardingRule(ctx, id, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForwardingRule", reflect.TypeOf((*MockLoadBalancerService)(nil).DeleteForwardingRule), ctx, id, ruleID)
+}
+
+// DeleteLoadBalancer mocks base method.
+func (m *MockLoadBalancerService) DeleteLoadBalancer(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancer", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoadBalancer indicates an expected call of DeleteLoadBalancer.
+func (mr *MockLoadBalancerServiceMockRecorder) DeleteLoadBalancer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockLoadBalancerService)(nil).DeleteLoadBalancer), ctx, id)
+}
+
+// EnsureTargets mocks base method.
+func (m *MockLoadBalancerService) EnsureTargets(ctx context.Context, id uuid.UUID, vmIDs []uuid.UUID) (*[]lb.Target, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureTargets", ctx, id, vmIDs)
+	ret0, _ := ret[0].(*[]lb.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureTargets indicates an expected call of EnsureTargets.
+func (mr *MockLoadBalancerServiceMockRecorder) EnsureTargets(ctx, id, vmIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureTargets", reflect.TypeOf((*MockLoadBalancerService)(nil).EnsureTargets), ctx, id, vmIDs)
+}
+
+// GetLoadBalancer mocks base method.
+func (m *MockLoadBalancerService) GetLoadBalancer(ctx context.Context, id uuid.UUID) (*lb.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancer", ctx, id)
+	ret0, _ := ret[0].(*lb.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoadBalancer indicates an expected call of GetLoadBalancer.
+func (mr *MockLoadBalancerServiceMockRecorder) GetLoadBalancer(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancer", reflect.TypeOf((*MockLoadBalancerService)(nil).GetLoadBalancer), ctx, id)
+}
+
+// ListLoadBalancers mocks base method.
+func (m *MockLoadBalancerService) ListLoadBalancers(ctx context.Context) (*[]lb.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancers", ctx)
+	ret0, _ := ret[0].(*[]lb.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancers indicates an expected call of ListLoadBalancers.
+func (mr *MockLoadBalancerServiceMockRecorder) ListLoadBalancers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancers", reflect.TypeOf((*MockLoadBalancerService)(nil).ListLoadBalancers), ctx)
+}
+
+// ListLoadBalancersIterator mocks base method.
+func (m *MockLoadBalancerService) ListLoadBalancersIterator(limit int) *api.Iterator[lb.LoadBalancer] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancersIterator", limit)
+	ret0, _ := ret[0].(*api.Iterator[lb.LoadBalancer])
+	return ret0
+}
+
+// ListLoadBalancersIterator indicates an expected call of ListLoadBalancersIterator.
+func (mr *MockLoadBalancerServiceMockRecorder) ListLoadBalancersIterator(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancersIterator", reflect.TypeOf((*MockLoadBalancerService)(nil).ListLoadBalancersIterator), limit)
+}
+
+// RemoveTarget mocks base method.
+func (m *MockLoadBalancerService) RemoveTarget(ctx context.Context, id, targetID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTarget", ctx, id, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTarget indicates an expected call of RemoveTarget.
+func (mr *MockLoadBalancerServiceMockRecorder) RemoveTarget(ctx, id, targetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTarget", reflect.TypeOf((*MockLoadBalancerService)(nil).RemoveTarget), ctx, id, targetID)
+}
+
+// ReplaceTargets mocks base method.
+func (m *MockLoadBalancerService) ReplaceTargets(ctx context.Context, id uuid.UUID, vmIDs []uuid.UUID) (*[]lb.Target, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTargets", ctx, id, vmIDs)
+	ret0, _ := ret[0].(*[]lb.Target)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceTargets indicates an expected call of ReplaceTargets.
+func (mr *MockLoadBalancerServiceMockRecorder) ReplaceTargets(ctx, id, vmIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTargets", reflect.TypeOf((*MockLoadBalancerService)(nil).ReplaceTargets), ctx, id, vmIDs)
+}
+
+// UpdateHealthCheck mocks base method.
+func (m *MockLoadBalancerService) UpdateHealthCheck(ctx context.Context, id uuid.UUID, hc lb.HealthCheck) (*lb.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHealthCheck", ctx, id, hc)
+	ret0, _ := ret[0].(*lb.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHealthCheck indicates an expected call of UpdateHealthCheck.
+func (mr *MockLoadBalancerServiceMockRecorder) UpdateHealthCheck(ctx, id, hc any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHealthCheck", reflect.TypeOf((*MockLoadBalancerService)(nil).UpdateHealthCheck), ctx, id, hc)
+}
+
+// MockKubernetesService is a mock of KubernetesService interface.
+type MockKubernetesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockKubernetesServiceMockRecorder
+}
+
+// MockKubernetesServiceMockRecorder is the mock recorder for MockKubernetesService.
+type MockKubernetesServiceMockRecorder struct {
+	mock *MockKubernetesService
+}
+
+// NewMockKubernetesService creates a new mock instance.
+func NewMockKubernetesService(ctrl *gomock.Controller) *MockKubernetesService {
+	mock := &MockKubernetesService{ctrl: ctrl}
+	mock.recorder = &MockKubernetesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKubernetesService) EXPECT() *MockKubernetesServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCluster mocks base method.
+func (m *MockKubernetesService) CreateCluster(ctx context.Context, cfg kubernetes.CreateClusterConfig) (*kubernetes.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCluster", ctx, cfg)
+	ret0, _ := ret[0].(*kubernetes.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCluster indicates an expected call of CreateCluster.
+func (mr *MockKubernetesServiceMockRecorder) CreateCluster(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCluster", reflect.TypeOf((*MockKubernetesService)(nil).CreateCluster), ctx, cfg)
+}
+
+// CreateNodePool mocks base method.
+func (m *MockKubernetesService) CreateNodePool(ctx context.Context, id uuid.UUID, cfg kubernetes.NodePoolConfig) (*kubernetes.NodePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNodePool", ctx, id, cfg)
+	ret0, _ := ret[0].(*kubernetes.NodePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNodePool indicates an expected call of CreateNodePool.
+func (mr *MockKubernetesServiceMockRecorder) CreateNodePool(ctx, id, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNodePool", reflect.TypeOf((*MockKubernetesService)(nil).CreateNodePool), ctx, id, cfg)
+}
+
+// DeleteCluster mocks base method.
+func (m *MockKubernetesService) DeleteCluster(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCluster", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCluster indicates an expected call of DeleteCluster.
+func (mr *MockKubernetesServiceMockRecorder) DeleteCluster(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockKubernetesService)(nil).DeleteCluster), ctx, id)
+}
+
+// DeleteNodePool mocks base method.
+func (m *MockKubernetesService) DeleteNodePool(ctx context.Context, id, poolID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNodePool", ctx, id, poolID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNodePool indicates an expected call of DeleteNodePool.
+func (mr *MockKubernetesServiceMockRecorder) DeleteNodePool(ctx, id, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNodePool", reflect.TypeOf((*MockKubernetesService)(nil).DeleteNodePool), ctx, id, poolID)
+}
+
+// GetCluster mocks base method.
+func (m *MockKubernetesService) GetCluster(ctx context.Context, id uuid.UUID) (*kubernetes.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCluster", ctx, id)
+	ret0, _ := ret[0].(*kubernetes.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCluster indicates an expected call of GetCluster.
+func (mr *MockKubernetesServiceMockRecorder) GetCluster(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCluster", reflect.TypeOf((*MockKubernetesService)(nil).GetCluster), ctx, id)
+}
+
+// GetKubeconfig mocks base method.
+func (m *MockKubernetesService) GetKubeconfig(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubeconfig", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubeconfig indicates an expected call of GetKubeconfig.
+func (mr *MockKubernetesServiceMockRecorder) GetKubeconfig(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubeconfig", reflect.TypeOf((*MockKubernetesService)(nil).GetKubeconfig), ctx, id)
+}
+
+// GetNodePool mocks base method.
+func (m *MockKubernetesService) GetNodePool(ctx context.Context, id, poolID uuid.UUID) (*kubernetes.NodePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodePool", ctx, id, poolID)
+	ret0, _ := ret[0].(*kubernetes.NodePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodePool indicates an expected call of GetNodePool.
+func (mr *MockKubernetesServiceMockRecorder) GetNodePool(ctx, id, poolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodePool", reflect.TypeOf((*MockKubernetesService)(nil).GetNodePool), ctx, id, poolID)
+}
+
+// ListClusters mocks base method.
+func (m *MockKubernetesService) ListClusters(ctx context.Context) (*[]kubernetes.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusters", ctx)
+	ret0, _ := ret[0].(*[]kubernetes.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusters indicates an expected call of ListClusters.
+func (mr *MockKubernetesServiceMockRecorder) ListClusters(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockKubernetesService)(nil).ListClusters), ctx)
+}
+
+// ListClustersIterator mocks base method.
+func (m *MockKubernetesService) ListClustersIterator(limit int) *api.Iterator[kubernetes.Cluster] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClustersIterator", limit)
+	ret0, _ := ret[0].(*api.Iterator[kubernetes.Cluster])
+	return ret0
+}
+
+// ListClustersIterator indicates an expected call of ListClustersIterator.
+func (mr *MockKubernetesServiceMockRecorder) ListClustersIterator(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersIterator", reflect.TypeOf((*MockKubernetesService)(nil).ListClustersIterator), limit)
+}
+
+// ListNodePools mocks base method.
+func (m *MockKubernetesService) ListNodePools(ctx context.Context, id uuid.UUID) (*[]kubernetes.NodePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodePools", ctx, id)
+	ret0, _ := ret[0].(*[]kubernetes.NodePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNodePools indicates an expected call of ListNodePools.
+func (mr *MockKubernetesServiceMockRecorder) ListNodePools(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodePools", reflect.TypeOf((*MockKubernetesService)(nil).ListNodePools), ctx, id)
+}
+
+// ScaleNodePool mocks base method.
+func (m *MockKubernetesService) ScaleNodePool(ctx context.Context, id, poolID uuid.UUID, nodeCount int) (*kubernetes.NodePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleNodePool", ctx, id, poolID, nodeCount)
+	ret0, _ := ret[0].(*kubernetes.NodePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleNodePool indicates an expected call of ScaleNodePool.
+func (mr *MockKubernetesServiceMockRecorder) ScaleNodePool(ctx, id, poolID, nodeCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleNodePool", reflect.TypeOf((*MockKubernetesService)(nil).ScaleNodePool), ctx, id, poolID, nodeCount)
+}
+
+// WaitForNodePool mocks base method.
+func (m *MockKubernetesService) WaitForNodePool(ctx context.Context, id, poolID uuid.UUID, opts ...waiter.Option) (*kubernetes.NodePool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, poolID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForNodePool", varargs...)
+	ret0, _ := ret[0].(*kubernetes.NodePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForNodePool indicates an expected call of WaitForNodePool.
+func (mr *MockKubernetesServiceMockRecorder) WaitForNodePool(ctx, id, poolID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, poolID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForNodePool", reflect.TypeOf((*MockKubernetesService)(nil).WaitForNodePool), varargs...)
+}
+
+// WaitForNodePoolDeleted mocks base method.
+func (m *MockKubernetesService) WaitForNodePoolDeleted(ctx context.Context, id, poolID uuid.UUID, opts ...waiter.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, poolID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForNodePoolDeleted", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForNodePoolDeleted indicates an expected call of WaitForNodePoolDeleted.
+func (mr *MockKubernetesServiceMockRecorder) WaitForNodePoolDeleted(ctx, id, poolID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, poolID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForNodePoolDeleted", reflect.TypeOf((*MockKubernetesService)(nil).WaitForNodePoolDeleted), varargs...)
+}
+
+// WriteKubeconfig mocks base method.
+func (m *MockKubernetesService) WriteKubeconfig(ctx context.Context, id uuid.UUID, path string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteKubeconfig", ctx, id, path)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteKubeconfig indicates an expected call of WriteKubeconfig.
+func (mr *MockKubernetesServiceMockRecorder) WriteKubeconfig(ctx, id, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteKubeconfig", reflect.TypeOf((*MockKubernetesService)(nil).WriteKubeconfig), ctx, id, path)
+}
+
+// MockBillingService is a mock of BillingService interface.
+type MockBillingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBillingServiceMockRecorder
+}
+
+// MockBillingServiceMockRecorder is the mock recorder for MockBillingService.
+type MockBillingServiceMockRecorder struct {
+	mock *MockBillingService
+}
+
+// NewMockBillingService creates a new mock instance.
+func NewMockBillingService(ctrl *gomock.Controller) *MockBillingService {
+	mock := &MockBillingService{ctrl: ctrl}
+	mock.recorder = &MockBillingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBillingService) EXPECT() *MockBillingServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckBalance mocks base method.
+func (m *MockBillingService) CheckBalance(ctx context.Context, id int, min float64) (*billing.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckBalance", ctx, id, min)
+	ret0, _ := ret[0].(*billing.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckBalance indicates an expected call of CheckBalance.
+func (mr *MockBillingServiceMockRecorder) CheckBalance(ctx, id, min any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckBalance", reflect.TypeOf((*MockBillingService)(nil).CheckBalance), ctx, id, min)
+}
+
+// DownloadInvoicePDF mocks base method.
+func (m *MockBillingService) DownloadInvoicePDF(ctx context.Context, invoiceID int, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadInvoicePDF", ctx, invoiceID, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadInvoicePDF indicates an expected call of DownloadInvoicePDF.
+func (mr *MockBillingServiceMockRecorder) DownloadInvoicePDF(ctx, invoiceID, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadInvoicePDF", reflect.TypeOf((*MockBillingService)(nil).DownloadInvoicePDF), ctx, invoiceID, w)
+}
+
+// GetBalance mocks base method.
+func (m *MockBillingService) GetBalance(ctx context.Context, id int) (*billing.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, id)
+	ret0, _ := ret[0].(*billing.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockBillingServiceMockRecorder) GetBalance(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBillingService)(nil).GetBalance), ctx, id)
+}
+
+// GetBillingAccount mocks base method.
+func (m *MockBillingService) GetBillingAccount(ctx context.Context, id int) (*billing.BillingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBillingAccount", ctx, id)
+	ret0, _ := ret[0].(*billing.BillingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBillingAccount indicates an expected call of GetBillingAccount.
+func (mr *MockBillingServiceMockRecorder) GetBillingAccount(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBillingAccount", reflect.TypeOf((*MockBillingService)(nil).GetBillingAccount), ctx, id)
+}
+
+// GetDefaultBillingAccount mocks base method.
+func (m *MockBillingService) GetDefaultBillingAccount(ctx context.Context) (*billing.BillingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultBillingAccount", ctx)
+	ret0, _ := ret[0].(*billing.BillingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultBillingAccount indicates an expected call of GetDefaultBillingAccount.
+func (mr *MockBillingServiceMockRecorder) GetDefaultBillingAccount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultBillingAccount", reflect.TypeOf((*MockBillingService)(nil).GetDefaultBillingAccount), ctx)
+}
+
+// GetInvoice mocks base method.
+func (m *MockBillingService) GetInvoice(ctx context.Context, invoiceID int) (*billing.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoice", ctx, invoiceID)
+	ret0, _ := ret[0].(*billing.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoice indicates an expected call of GetInvoice.
+func (mr *MockBillingServiceMockRecorder) GetInvoice(ctx, invoiceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoice", reflect.TypeOf((*MockBillingService)(nil).GetInvoice), ctx, invoiceID)
+}
+
+// GetLimits mocks base method.
+func (m *MockBillingService) GetLimits(ctx context.Context, id int) (*billing.Limits, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLimits", ctx, id)
+	ret0, _ := ret[0].(*billing.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLimits indicates an expected call of GetLimits.
+func (mr *MockBillingServiceMockRecorder) GetLimits(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLimits", reflect.TypeOf((*MockBillingService)(nil).GetLimits), ctx, id)
+}
+
+// GetOngoingUsage mocks base method.
+func (m *MockBillingService) GetOngoingUsage(ctx context.Context, id int) (*billing.Usage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOngoingUsage", ctx, id)
+	ret0, _ := ret[0].(*billing.Usage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOngoingUsage indicates an expected call of GetOngoingUsage.
+func (mr *MockBillingServiceMockRecorder) GetOngoingUsage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOngoingUsage", reflect.TypeOf((*MockBillingService)(nil).GetOngoingUsage), ctx, id)
+}
+
+// GetUsageReport mocks base method.
+func (m *MockBillingService) GetUsageReport(ctx context.Context, id int, from, to time.Time) (*billing.UsageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsageReport", ctx, id, from, to)
+	ret0, _ := ret[0].(*billing.UsageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsageReport indicates an expected call of GetUsageReport.
+func (mr *MockBillingServiceMockRecorder) GetUsageReport(ctx, id, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsageReport", reflect.TypeOf((*MockBillingService)(nil).GetUsageReport), ctx, id, from, to)
+}
+
+// ListBillingAccounts mocks base method.
+func (m *MockBillingService) ListBillingAccounts(ctx context.Context) (*[]billing.BillingAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBillingAccounts", ctx)
+	ret0, _ := ret[0].(*[]billing.BillingAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBillingAccounts indicates an expected call of ListBillingAccounts.
+func (mr *MockBillingServiceMockRecorder) ListBillingAccounts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBillingAccounts", reflect.TypeOf((*MockBillingService)(nil).ListBillingAccounts), ctx)
+}
+
+// ListBillingAccountsIterator mocks base method.
+func (m *MockBillingService) ListBillingAccountsIterator(limit int) *api.Iterator[billing.BillingAccount] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBillingAccountsIterator", limit)
+	ret0, _ := ret[0].(*api.Iterator[billing.BillingAccount])
+	return ret0
+}
+
+// ListBillingAccountsIterator indicates an expected call of ListBillingAccountsIterator.
+func (mr *MockBillingServiceMockRecorder) ListBillingAccountsIterator(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBillingAccountsIterator", reflect.TypeOf((*MockBillingService)(nil).ListBillingAccountsIterator), limit)
+}
+
+// ListInvoices mocks base method.
+func (m *MockBillingService) ListInvoices(ctx context.Context, id int) (*[]billing.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvoices", ctx, id)
+	ret0, _ := ret[0].(*[]billing.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvoices indicates an expected call of ListInvoices.
+func (mr *MockBillingServiceMockRecorder) ListInvoices(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvoices", reflect.TypeOf((*MockBillingService)(nil).ListInvoices), ctx, id)
+}
+
+// MockSSHKeyService is a mock of SSHKeyService interface.
+type MockSSHKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSSHKeyServiceMockRecorder
+}
+
+// MockSSHKeyServiceMockRecorder is the mock recorder for MockSSHKeyService.
+type MockSSHKeyServiceMockRecorder struct {
+	mock *MockSSHKeyService
+}
+
+// NewMockSSHKeyService creates a new mock instance.
+func NewMockSSHKeyService(ctrl *gomock.Controller) *MockSSHKeyService {
+	mock := &MockSSHKeyService{ctrl: ctrl}
+	mock.recorder = &MockSSHKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSSHKeyService) EXPECT() *MockSSHKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// AddSSHKey mocks base method.
+func (m *MockSSHKeyService) AddSSHKey(ctx context.Context, name, publicKey string) (*sshkey.SSHKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSSHKey", ctx, name, publicKey)
+	ret0, _ := ret[0].(*sshkey.SSHKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSSHKey indicates an expected call of AddSSHKey.
+func (mr *MockSSHKeyServiceMockRecorder) AddSSHKey(ctx, name, publicKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSSHKey", reflect.TypeOf((*MockSSHKeyService)(nil).AddSSHKey), ctx, name, publicKey)
+}
+
+// DeleteSSHKey mocks base method.
+func (m *MockSSHKeyService) DeleteSSHKey(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSSHKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSSHKey indicates an expected call of DeleteSSHKey.
+func (mr *MockSSHKeyServiceMockRecorder) DeleteSSHKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSSHKey", reflect.TypeOf((*MockSSHKeyService)(nil).DeleteSSHKey), ctx, id)
+}
+
+// ListSSHKeys mocks base method.
+func (m *MockSSHKeyService) ListSSHKeys(ctx context.Context) (*[]sshkey.SSHKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSSHKeys", ctx)
+	ret0, _ := ret[0].(*[]sshkey.SSHKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSSHKeys indicates an expected call of ListSSHKeys.
+func (mr *MockSSHKeyServiceMockRecorder) ListSSHKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHKeys", reflect.TypeOf((*MockSSHKeyService)(nil).ListSSHKeys), ctx)
+}
+
+// MockImageService is a mock of ImageService interface.
+type MockImageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageServiceMockRecorder
+}
+
+// MockImageServiceMockRecorder is the mock recorder for MockImageService.
+type MockImageServiceMockRecorder struct {
+	mock *MockImageService
+}
+
+// NewMockImageService creates a new mock instance.
+func NewMockImageService(ctrl *gomock.Controller) *MockImageService {
+	mock := &MockImageService{ctrl: ctrl}
+	mock.recorder = &MockImageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageService) EXPECT() *MockImageServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateImageFromDisk mocks base method.
+func (m *MockImageService) CreateImageFromDisk(ctx context.Context, diskID uuid.UUID, name string) (*image.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImageFromDisk", ctx, diskID, name)
+	ret0, _ := ret[0].(*image.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImageFromDisk indicates an expected call of CreateImageFromDisk.
+func (mr *MockImageServiceMockRecorder) CreateImageFromDisk(ctx, diskID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImageFromDisk", reflect.TypeOf((*MockImageService)(nil).CreateImageFromDisk), ctx, diskID, name)
+}
+
+// DeleteImage mocks base method.
+func (m *MockImageService) DeleteImage(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockImageServiceMockRecorder) DeleteImage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockImageService)(nil).DeleteImage), ctx, id)
+}
+
+// FindImage mocks base method.
+func (m *MockImageService) FindImage(ctx context.Context, name, constraint string) (*image.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindImage", ctx, name, constraint)
+	ret0, _ := ret[0].(*image.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindImage indicates an expected call of FindImage.
+func (mr *MockImageServiceMockRecorder) FindImage(ctx, name, constraint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindImage", reflect.TypeOf((*MockImageService)(nil).FindImage), ctx, name, constraint)
+}
+
+// ListDiskImages mocks base method.
+func (m *MockImageService) ListDiskImages(ctx context.Context) (*[]image.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiskImages", ctx)
+	ret0, _ := ret[0].(*[]image.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiskImages indicates an expected call of ListDiskImages.
+func (mr *MockImageServiceMockRecorder) ListDiskImages(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskImages", reflect.TypeOf((*MockImageService)(nil).ListDiskImages), ctx)
+}
+
+// ListImages mocks base method.
+func (m *MockImageService) ListImages(ctx context.Context) (*[]image.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", ctx)
+	ret0, _ := ret[0].(*[]image.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockImageServiceMockRecorder) ListImages(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockImageService)(nil).ListImages), ctx)
+}
+
+// ListOSImages mocks base method.
+func (m *MockImageService) ListOSImages(ctx context.Context) (*[]image.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOSImages", ctx)
+	ret0, _ := ret[0].(*[]image.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOSImages indicates an expected call of ListOSImages.
+func (mr *MockImageServiceMockRecorder) ListOSImages(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOSImages", reflect.TypeOf((*MockImageService)(nil).ListOSImages), ctx)
+}
+
+// MockTokenService is a mock of TokenService interface.
+type MockTokenService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenServiceMockRecorder
+}
+
+// MockTokenServiceMockRecorder is the mock recorder for MockTokenService.
+type MockTokenServiceMockRecorder struct {
+	mock *MockTokenService
+}
+
+// NewMockTokenService creates a new mock instance.
+func NewMockTokenService(ctrl *gomock.Controller) *MockTokenService {
+	mock := &MockTokenService{ctrl: ctrl}
+	mock.recorder = &MockTokenServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenService) EXPECT() *MockTokenServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateToken mocks base method.
+func (m *MockTokenService) CreateToken(ctx context.Context, cfg token.CreateTokenConfig) (*token.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateToken", ctx, cfg)
+	ret0, _ := ret[0].(*token.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateToken indicates an expected call of CreateToken.
+func (mr *MockTokenServiceMockRecorder) CreateToken(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateToken", reflect.TypeOf((*MockTokenService)(nil).CreateToken), ctx, cfg)
+}
+
+// ListTokens mocks base method.
+func (m *MockTokenService) ListTokens(ctx context.Context) (*[]token.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTokens", ctx)
+	ret0, _ := ret[0].(*[]token.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTokens indicates an expected call of ListTokens.
+func (mr *MockTokenServiceMockRecorder) ListTokens(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokens", reflect.TypeOf((*MockTokenService)(nil).ListTokens), ctx)
+}
+
+// RevokeToken mocks base method.
+func (m *MockTokenService) RevokeToken(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeToken", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeToken indicates an expected call of RevokeToken.
+func (mr *MockTokenServiceMockRecorder) RevokeToken(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockTokenService)(nil).RevokeToken), ctx, id)
+}
+
+// MockAccountService is a mock of AccountService interface.
+type MockAccountService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountServiceMockRecorder
+}
+
+// MockAccountServiceMockRecorder is the mock recorder for MockAccountService.
+type MockAccountServiceMockRecorder struct {
+	mock *MockAccountService
+}
+
+// NewMockAccountService creates a new mock instance.
+func NewMockAccountService(ctrl *gomock.Controller) *MockAccountService {
+	mock := &MockAccountService{ctrl: ctrl}
+	mock.recorder = &MockAccountServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountService) EXPECT() *MockAccountServiceMockRecorder {
+	return m.recorder
+}
+
+// GetProfile mocks base method.
+func (m *MockAccountService) GetProfile(ctx context.Context) (*account.Profile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx)
+	ret0, _ := ret[0].(*account.Profile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockAccountServiceMockRecorder) GetProfile(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockAccountService)(nil).GetProfile), ctx)
+}
+
+// InviteTeamMember mocks base method.
+func (m *MockAccountService) InviteTeamMember(ctx context.Context, cfg account.InviteConfig) (*account.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteTeamMember", ctx, cfg)
+	ret0, _ := ret[0].(*account.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteTeamMember indicates an expected call of InviteTeamMember.
+func (mr *MockAccountServiceMockRecorder) InviteTeamMember(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteTeamMember", reflect.TypeOf((*MockAccountService)(nil).InviteTeamMember), ctx, cfg)
+}
+
+// ListTeamMembers mocks base method.
+func (m *MockAccountService) ListTeamMembers(ctx context.Context) (*[]account.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamMembers", ctx)
+	ret0, _ := ret[0].(*[]account.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamMembers indicates an expected call of ListTeamMembers.
+func (mr *MockAccountServiceMockRecorder) ListTeamMembers(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamMembers", reflect.TypeOf((*MockAccountService)(nil).ListTeamMembers), ctx)
+}
+
+// RemoveTeamMember mocks base method.
+func (m *MockAccountService) RemoveTeamMember(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTeamMember", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTeamMember indicates an expected call of RemoveTeamMember.
+func (mr *MockAccountServiceMockRecorder) RemoveTeamMember(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTeamMember", reflect.TypeOf((*MockAccountService)(nil).RemoveTeamMember), ctx, id)
+}
+
+// MockEventsService is a mock of EventsService interface.
+type MockEventsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventsServiceMockRecorder
+}
+
+// MockEventsServiceMockRecorder is the mock recorder for MockEventsService.
+type MockEventsServiceMockRecorder struct {
+	mock *MockEventsService
+}
+
+// NewMockEventsService creates a new mock instance.
+func NewMockEventsService(ctrl *gomock.Controller) *MockEventsService {
+	mock := &MockEventsService{ctrl: ctrl}
+	mock.recorder = &MockEventsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventsService) EXPECT() *MockEventsServiceMockRecorder {
+	return m.recorder
+}
+
+// ListEvents mocks base method.
+func (m *MockEventsService) ListEvents(ctx context.Context, opts events.ListEventsOptions) (*[]events.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, opts)
+	ret0, _ := ret[0].(*[]events.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockEventsServiceMockRecorder) ListEvents(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventsService)(nil).ListEvents), ctx, opts)
+}
+
+// ListEventsIterator mocks base method.
+func (m *MockEventsService) ListEventsIterator(opts events.ListEventsOptions, limit int) *api.Iterator[events.Event] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventsIterator", opts, limit)
+	ret0, _ := ret[0].(*api.Iterator[events.Event])
+	return ret0
+}
+
+// ListEventsIterator indicates an expected call of ListEventsIterator.
+func (mr *MockEventsServiceMockRecorder) ListEventsIterator(opts, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventsIterator", reflect.TypeOf((*MockEventsService)(nil).ListEventsIterator), opts, limit)
+}
+
+// MockWebhookService is a mock of WebhookService interface.
+type MockWebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookServiceMockRecorder
+}
+
+// MockWebhookServiceMockRecorder is the mock recorder for MockWebhookService.
+type MockWebhookServiceMockRecorder struct {
+	mock *MockWebhookService
+}
+
+// NewMockWebhookService creates a new mock instance.
+func NewMockWebhookService(ctrl *gomock.Controller) *MockWebhookService {
+	mock := &MockWebhookService{ctrl: ctrl}
+	mock.recorder = &MockWebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookService) EXPECT() *MockWebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockWebhookService) CreateWebhook(ctx context.Context, cfg webhook.CreateWebhookConfig) (*webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, cfg)
+	ret0, _ := ret[0].(*webhook.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockWebhookServiceMockRecorder) CreateWebhook(ctx, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookService)(nil).CreateWebhook), ctx, cfg)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookService) DeleteWebhook(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookServiceMockRecorder) DeleteWebhook(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookService)(nil).DeleteWebhook), ctx, id)
+}
+
+// GetNotificationPreferences mocks base method.
+func (m *MockWebhookService) GetNotificationPreferences(ctx context.Context) (*webhook.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationPreferences", ctx)
+	ret0, _ := ret[0].(*webhook.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationPreferences indicates an expected call of GetNotificationPreferences.
+func (mr *MockWebhookServiceMockRecorder) GetNotificationPreferences(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationPreferences", reflect.TypeOf((*MockWebhookService)(nil).GetNotificationPreferences), ctx)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockWebhookService) ListWebhooks(ctx context.Context) (*[]webhook.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx)
+	ret0, _ := ret[0].(*[]webhook.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockWebhookServiceMockRecorder) ListWebhooks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockWebhookService)(nil).ListWebhooks), ctx)
+}
+
+// UpdateNotificationPreferences mocks base method.
+func (m *MockWebhookService) UpdateNotificationPreferences(ctx context.Context, prefs webhook.NotificationPreferences) (*webhook.NotificationPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationPreferences", ctx, prefs)
+	ret0, _ := ret[0].(*webhook.NotificationPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationPreferences indicates an expected call of UpdateNotificationPreferences.
+func (mr *MockWebhookServiceMockRecorder) UpdateNotificationPreferences(ctx, prefs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationPreferences", reflect.TypeOf((*MockWebhookService)(nil).UpdateNotificationPreferences), ctx, prefs)
+}
+
+// MockFirewallService is a mock of FirewallService interface.
+type MockFirewallService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFirewallServiceMockRecorder
+}
+
+// MockFirewallServiceMockRecorder is the mock recorder for MockFirewallService.
+type MockFirewallServiceMockRecorder struct {
+	mock *MockFirewallService
+}
+
+// NewMockFirewallService creates a new mock instance.
+func NewMockFirewallService(ctrl *gomock.Controller) *MockFirewallService {
+	mock := &MockFirewallService{ctrl: ctrl}
+	mock.recorder = &MockFirewallServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFirewallService) EXPECT() *MockFirewallServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateRule mocks base method.
+func (m *MockFirewallService) CreateRule(ctx context.Context, scope firewall.Scope, cfg firewall.RuleConfig) (*firewall.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", ctx, scope, cfg)
+	ret0, _ := ret[0].(*firewall.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockFirewallServiceMockRecorder) CreateRule(ctx, scope, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockFirewallService)(nil).CreateRule), ctx, scope, cfg)
+}
+
+// DeleteRule mocks base method.
+func (m *MockFirewallService) DeleteRule(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRule indicates an expected call of DeleteRule.
+func (mr *MockFirewallServiceMockRecorder) DeleteRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRule", reflect.TypeOf((*MockFirewallService)(nil).DeleteRule), ctx, id)
+}
+
+// ListRules mocks base method.
+func (m *MockFirewallService) ListRules(ctx context.Context, scope firewall.Scope) (*[]firewall.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx, scope)
+	ret0, _ := ret[0].(*[]firewall.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockFirewallServiceMockRecorder) ListRules(ctx, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockFirewallService)(nil).ListRules), ctx, scope)
+}
+
+// SyncRules mocks base method.
+func (m *MockFirewallService) SyncRules(ctx context.Context, scope firewall.Scope, desired []firewall.RuleConfig) (*[]firewall.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncRules", ctx, scope, desired)
+	ret0, _ := ret[0].(*[]firewall.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncRules indicates an expected call of SyncRules.
+func (mr *MockFirewallServiceMockRecorder) SyncRules(ctx, scope, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncRules", reflect.TypeOf((*MockFirewallService)(nil).SyncRules), ctx, scope, desired)
+}
+
+// MockDNSService is a mock of DNSService interface.
+type MockDNSService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDNSServiceMockRecorder
+}
+
+// MockDNSServiceMockRecorder is the mock recorder for MockDNSService.
+type MockDNSServiceMockRecorder struct {
+	mock *MockDNSService
+}
+
+// NewMockDNSService creates a new mock instance.
+func NewMockDNSService(ctrl *gomock.Controller) *MockDNSService {
+	mock := &MockDNSService{ctrl: ctrl}
+	mock.recorder = &MockDNSServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDNSService) EXPECT() *MockDNSServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateRecord mocks base method.
+func (m *MockDNSService) CreateRecord(ctx context.Context, zoneID uuid.UUID, cfg dns.RecordConfig) (*dns.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecord", ctx, zoneID, cfg)
+	ret0, _ := ret[0].(*dns.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecord indicates an expected call of CreateRecord.
+func (mr *MockDNSServiceMockRecorder) CreateRecord(ctx, zoneID, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockDNSService)(nil).CreateRecord), ctx, zoneID, cfg)
+}
+
+// CreateZone mocks base method.
+func (m *MockDNSService) CreateZone(ctx context.Context, name string) (*dns.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZone", ctx, name)
+	ret0, _ := ret[0].(*dns.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateZone indicates an expected call of CreateZone.
+func (mr *MockDNSServiceMockRecorder) CreateZone(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockDNSService)(nil).CreateZone), ctx, name)
+}
+
+// DeleteRecord mocks base method.
+func (m *MockDNSService) DeleteRecord(ctx context.Context, zoneID, recordID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecord", ctx, zoneID, recordID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecord indicates an expected call of DeleteRecord.
+func (mr *MockDNSServiceMockRecorder) DeleteRecord(ctx, zoneID, recordID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockDNSService)(nil).DeleteRecord), ctx, zoneID, recordID)
+}
+
+// DeleteZone mocks base method.
+func (m *MockDNSService) DeleteZone(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteZone", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteZone indicates an expected call of DeleteZone.
+func (mr *MockDNSServiceMockRecorder) DeleteZone(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockDNSService)(nil).DeleteZone), ctx, id)
+}
+
+// FindZone mocks base method.
+func (m *MockDNSService) FindZone(ctx context.Context, fqdn string) (*dns.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindZone", ctx, fqdn)
+	ret0, _ := ret[0].(*dns.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindZone indicates an expected call of FindZone.
+func (mr *MockDNSServiceMockRecorder) FindZone(ctx, fqdn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindZone", reflect.TypeOf((*MockDNSService)(nil).FindZone), ctx, fqdn)
+}
+
+// GetZone mocks base method.
+func (m *MockDNSService) GetZone(ctx context.Context, id uuid.UUID) (*dns.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetZone", ctx, id)
+	ret0, _ := ret[0].(*dns.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetZone indicates an expected call of GetZone.
+func (mr *MockDNSServiceMockRecorder) GetZone(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZone", reflect.TypeOf((*MockDNSService)(nil).GetZone), ctx, id)
+}
+
+// ListRecords mocks base method.
+func (m *MockDNSService) ListRecords(ctx context.Context, zoneID uuid.UUID) (*[]dns.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecords", ctx, zoneID)
+	ret0, _ := ret[0].(*[]dns.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecords indicates an expected call of ListRecords.
+func (mr *MockDNSServiceMockRecorder) ListRecords(ctx, zoneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecords", reflect.TypeOf((*MockDNSService)(nil).ListRecords), ctx, zoneID)
+}
+
+// ListZones mocks base method.
+func (m *MockDNSService) ListZones(ctx context.Context) (*[]dns.Zone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZones", ctx)
+	ret0, _ := ret[0].(*[]dns.Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListZones indicates an expected call of ListZones.
+func (mr *MockDNSServiceMockRecorder) ListZones(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZones", reflect.TypeOf((*MockDNSService)(nil).ListZones), ctx)
+}
+
+// UpdateRecord mocks base method.
+func (m *MockDNSService) UpdateRecord(ctx context.Context, zoneID, recordID uuid.UUID, cfg dns.RecordConfig) (*dns.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecord", ctx, zoneID, recordID, cfg)
+	ret0, _ := ret[0].(*dns.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecord indicates an expected call of UpdateRecord.
+func (mr *MockDNSServiceMockRecorder) UpdateRecord(ctx, zoneID, recordID, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecord", reflect.TypeOf((*MockDNSService)(nil).UpdateRecord), ctx, zoneID, recordID, cfg)
+}
+
+// UpsertRecords mocks base method.
+func (m *MockDNSService) UpsertRecords(ctx context.Context, zoneID uuid.UUID, records []dns.RecordConfig) (*[]dns.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertRecords", ctx, zoneID, records)
+	ret0, _ := ret[0].(*[]dns.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertRecords indicates an expected call of UpsertRecords.
+func (mr *MockDNSServiceMockRecorder) UpsertRecords(ctx, zoneID, records any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertRecords", reflect.TypeOf((*MockDNSService)(nil).UpsertRecords), ctx, zoneID, records)
+}
@@ -0,0 +1,40 @@
+package mocks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ekaputra07/warren-go"
+	"github.com/ekaputra07/warren-go/mocks"
+	"github.com/ekaputra07/warren-go/vm"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMockVMService(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	id := uuid.New()
+
+	m := mocks.NewMockVMService(ctrl)
+	m.EXPECT().StartVM(gomock.Any(), id).Return(&vm.VM{UUID: id, Status: vm.StatusRunning}, nil)
+
+	var svc warren.VMService = m
+	v, err := svc.StartVM(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, vm.StatusRunning, v.Status)
+}
+
+func TestWarrenWithMocks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	id := uuid.New()
+
+	w := warren.NewClient("https://api.warren.io", "secret", "jkt01")
+	m := mocks.NewMockVMService(ctrl)
+	m.EXPECT().GetVM(gomock.Any(), id).Return(&vm.VM{UUID: id, Status: vm.StatusStopped}, nil)
+	w.VM = m
+
+	v, err := w.VM.GetVM(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, vm.StatusStopped, v.Status)
+}
@@ -2,7 +2,6 @@ package objectstorage
 
 import (
 	"context"
-	"encoding/json"
 	"net/url"
 	"strconv"
 
@@ -27,12 +26,8 @@ func (c *Client) GetS3ApiURL(ctx context.Context) (*map[string]string, error) {
 		Method: "GET",
 		Path:   "/v1/storage/api/s3",
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return nil, resp.Error
-	}
 	var data map[string]string
-	if err := json.Unmarshal(resp.Body, &data); err != nil {
+	if err := c.API.FormRequest(ctx, rc).Into(&data); err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -44,12 +39,8 @@ func (c *Client) GetS3UserInfo(ctx context.Context) (*S3UserInfo, error) {
 		Method: "GET",
 		Path:   "/v1/storage/user",
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return nil, resp.Error
-	}
 	var info S3UserInfo
-	if err := json.Unmarshal(resp.Body, &info); err != nil {
+	if err := c.API.FormRequest(ctx, rc).Into(&info); err != nil {
 		return nil, err
 	}
 	return &info, nil
@@ -61,12 +52,8 @@ func (c *Client) GetS3UserKeys(ctx context.Context) (*[]S3Credential, error) {
 		Method: "GET",
 		Path:   "/v1/storage/user/keys",
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return nil, resp.Error
-	}
 	var credentials []S3Credential
-	if err := json.Unmarshal(resp.Body, &credentials); err != nil {
+	if err := c.API.FormRequest(ctx, rc).Into(&credentials); err != nil {
 		return nil, err
 	}
 	return &credentials, nil
@@ -78,12 +65,8 @@ func (c *Client) GenerateS3UserKey(ctx context.Context) (*[]S3Credential, error)
 		Method: "POST",
 		Path:   "/v1/storage/user/keys",
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return nil, resp.Error
-	}
 	var credentials []S3Credential
-	if err := json.Unmarshal(resp.Body, &credentials); err != nil {
+	if err := c.API.FormRequest(ctx, rc).Into(&credentials); err != nil {
 		return nil, err
 	}
 	return &credentials, nil
@@ -101,33 +84,40 @@ func (c *Client) DeleteS3UserKey(ctx context.Context, accessKey string) error {
 
 // ListBuckets https://api.warren.io/#list-buckets
 func (c *Client) ListBuckets(ctx context.Context) (*[]S3Bucket, error) {
-	var resp *api.ClientResponse
-
-	if c.BillingAccountID == 0 {
-		rc := api.RequestConfig{
-			Method: "GET",
-			Path:   "/v1/storage/bucket/list",
-		}
-		resp = c.API.FormRequest(ctx, rc)
-	} else {
-		rc := api.RequestConfig{
-			Method: "GET",
-			Path:   "/v1/storage/bucket/list",
-			Query:  url.Values{"billing_account_id": []string{strconv.Itoa(c.BillingAccountID)}},
-		}
-		resp = c.API.FormRequest(ctx, rc)
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/storage/bucket/list",
 	}
-
-	if resp.Error != nil {
-		return nil, resp.Error
+	if c.BillingAccountID != 0 {
+		rc.Query = url.Values{"billing_account_id": []string{strconv.Itoa(c.BillingAccountID)}}
 	}
 	var buckets []S3Bucket
-	if err := json.Unmarshal(resp.Body, &buckets); err != nil {
+	if err := c.API.FormRequest(ctx, rc).Into(&buckets); err != nil {
 		return nil, err
 	}
 	return &buckets, nil
 }
 
+// ListBucketsIterator returns iterator over `ListBuckets()` results, fetching limit items per page.
+func (c *Client) ListBucketsIterator(limit int) *api.Iterator[S3Bucket] {
+	return api.NewIterator(func(ctx context.Context, page, limit int) ([]S3Bucket, error) {
+		rc := api.RequestConfig{
+			Method: "GET",
+			Path:   "/v1/storage/bucket/list",
+			Page:   page,
+			Limit:  limit,
+		}
+		if c.BillingAccountID != 0 {
+			rc.Query = url.Values{"billing_account_id": []string{strconv.Itoa(c.BillingAccountID)}}
+		}
+		var buckets []S3Bucket
+		if err := c.API.FormRequest(ctx, rc).Into(&buckets); err != nil {
+			return nil, err
+		}
+		return buckets, nil
+	}, limit)
+}
+
 // GetBucket https://api.warren.io/#get-bucket
 func (c *Client) GetBucket(ctx context.Context, bucketName string) (*S3Bucket, error) {
 	rc := api.RequestConfig{
@@ -135,12 +125,8 @@ func (c *Client) GetBucket(ctx context.Context, bucketName string) (*S3Bucket, e
 		Path:   "/v1/storage/bucket",
 		Query:  url.Values{"name": []string{bucketName}},
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return nil, resp.Error
-	}
 	var bucket S3Bucket
-	if err := json.Unmarshal(resp.Body, &bucket); err != nil {
+	if err := c.API.FormRequest(ctx, rc).Into(&bucket); err != nil {
 		return nil, err
 	}
 	return &bucket, nil
@@ -158,12 +144,8 @@ func (c *Client) CreateBucket(ctx context.Context, bucketName string) (*S3Bucket
 		Path:   "/v1/storage/bucket",
 		Data:   d,
 	}
-	resp := c.API.FormRequest(ctx, rc)
-	if resp.Error != nil {
-		return nil, resp.Error
-	}
 	var bucket S3Bucket
-	if err := json.Unmarshal(resp.Body, &bucket); err != nil {
+	if err := c.API.FormRequest(ctx, rc).Into(&bucket); err != nil {
 		return nil, err
 	}
 	return &bucket, nil
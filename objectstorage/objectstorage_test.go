@@ -1,6 +1,7 @@
 package objectstorage
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 	"testing"
@@ -9,7 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func ForBillingAccount(t *testing.T) {
+func TestForBillingAccount(t *testing.T) {
 	c := NewClient(api.Default).ForBillingAccount(123)
 	assert.Equal(t, 123, c.BillingAccountID)
 }
@@ -58,6 +59,20 @@ func TestGenerateS3UserKey(t *testing.T) {
 	os.GenerateS3UserKey(context.Background())
 }
 
+func TestGenerateS3UserKey_DryRun(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request in dry run", r.Method)
+	})
+	defer s.Close()
+
+	var log bytes.Buffer
+	os := Client{API: a.Clone(api.WithDryRun(&log))}
+	keys, err := os.GenerateS3UserKey(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, *keys)
+	assert.Equal(t, "dry run: POST "+s.URL+"/v1/storage/user/keys\n", log.String())
+}
+
 func TestDeleteS3UserKey(t *testing.T) {
 	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "DELETE", r.Method)
@@ -158,3 +173,18 @@ func TestUpdateBucketBillingAccount(t *testing.T) {
 	os := Client{API: a}
 	os.UpdateBucketBillingAccount(context.Background(), "testBucket", 123)
 }
+
+func TestListBucketsIterator(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/storage/bucket/list?billing_account_id=123&limit=2&page=1", r.RequestURI)
+		w.Write([]byte("[{}]"))
+	})
+	defer s.Close()
+
+	os := Client{API: a, BillingAccountID: 123}
+	it := os.ListBucketsIterator(2)
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
@@ -0,0 +1,156 @@
+// Package query encodes option structs into query string values, so list methods build query params consistently.
+//
+//	type ListEventsOptions struct {
+//		From         time.Time `qs:"start_time,omitempty"`
+//		ResourceType string    `qs:"resource_type,omitempty"`
+//		Metrics      []string  `qs:"metrics,omitempty,comma"`
+//	}
+//
+// Tag options:
+//   - omitempty: field with zero value (or empty slice) is skipped
+//   - comma: slice is joined with comma into a single value instead of repeating the key
+//   - date: time.Time is formatted as date (2006-01-02) instead of RFC3339
+//
+// Fields without `qs` tag, or tagged with "-", are skipped. time.Time is sent in UTC,
+// time.Duration in whole seconds and types implementing `encoding.TextMarshaler` (e.g. uuid.UUID) as their text.
+// Embedded structs are encoded as if their fields were declared in the outer struct.
+package query
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateLayout is used for time.Time fields with `date` option
+const DateLayout = "2006-01-02"
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	textType     = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Values encodes struct (or pointer to struct) v into url.Values using `qs` field tags, nil pointer results in empty values.
+func Values(v interface{}) (url.Values, error) {
+	q := url.Values{}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return q, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("Value with type of %T is invalid, must be struct", v)
+	}
+	if err := encodeStruct(q, rv); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func encodeStruct(q url.Values, rv reflect.Value) error {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		fv := rv.Field(i)
+		tag, ok := sf.Tag.Lookup("qs")
+		if !ok && sf.Anonymous && fv.Kind() == reflect.Struct {
+			if err := encodeStruct(q, fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if !ok || tag == "-" || !sf.IsExported() {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+		var omitEmpty, comma, date bool
+		for _, o := range parts[1:] {
+			switch o {
+			case "omitempty":
+				omitEmpty = true
+			case "comma":
+				comma = true
+			case "date":
+				date = true
+			}
+		}
+
+		if fv.Kind() == reflect.Pointer {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+		if omitEmpty && (fv.IsZero() || ((fv.Kind() == reflect.Slice || fv.Kind() == reflect.Map) && fv.Len() == 0)) {
+			continue
+		}
+
+		// arrays implementing TextMarshaler (e.g. uuid.UUID) are single values
+		if (fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array) && !fv.Type().Implements(textType) {
+			values := make([]string, 0, fv.Len())
+			for j := 0; j < fv.Len(); j++ {
+				s, err := format(fv.Index(j), date)
+				if err != nil {
+					return fmt.Errorf("%s: %w", sf.Name, err)
+				}
+				values = append(values, s)
+			}
+			if comma {
+				q.Add(name, strings.Join(values, ","))
+				continue
+			}
+			for _, s := range values {
+				q.Add(name, s)
+			}
+			continue
+		}
+
+		s, err := format(fv, date)
+		if err != nil {
+			return fmt.Errorf("%s: %w", sf.Name, err)
+		}
+		q.Add(name, s)
+	}
+	return nil
+}
+
+// format returns string representation of a single value.
+func format(v reflect.Value, date bool) (string, error) {
+	switch v.Type() {
+	case timeType:
+		t := v.Interface().(time.Time).UTC()
+		if date {
+			return t.Format(DateLayout), nil
+		}
+		return t.Format(time.RFC3339), nil
+	case durationType:
+		return strconv.FormatInt(int64(v.Interface().(time.Duration).Seconds()), 10), nil
+	}
+	if v.Type().Implements(textType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(b), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	}
+	return "", fmt.Errorf("type %s is not supported", v.Type())
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type Paging struct {
+	Page int `qs:"page,omitempty"`
+}
+
+type options struct {
+	Paging
+	BillingAccountID int           `qs:"billing_account_id,omitempty"`
+	Status           string        `qs:"status"`
+	UUID             uuid.UUID     `qs:"uuid,omitempty"`
+	From             time.Time     `qs:"start_time,omitempty"`
+	Day              time.Time     `qs:"date,omitempty,date"`
+	Step             time.Duration `qs:"step,omitempty"`
+	Metrics          []string      `qs:"metrics,omitempty,comma"`
+	IDs              []int         `qs:"id,omitempty"`
+	Enabled          *bool         `qs:"enabled"`
+	Ratio            float64       `qs:"ratio,omitempty"`
+	Ignored          string        `qs:"-"`
+	Untagged         string
+}
+
+func TestValues(t *testing.T) {
+	id := uuid.MustParse("4e5eadd3-8b11-4c34-812a-2cf97120b628")
+	enabled := true
+	loc := time.FixedZone("WIB", 7*60*60)
+	q, err := Values(&options{
+		Paging:           Paging{Page: 2},
+		BillingAccountID: 123,
+		UUID:             id,
+		From:             time.Date(2024, 1, 1, 7, 0, 0, 0, loc),
+		Day:              time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Step:             5 * time.Minute,
+		Metrics:          []string{"cpu", "ram"},
+		IDs:              []int{1, 2},
+		Enabled:          &enabled,
+		Ratio:            0.5,
+		Ignored:          "x",
+		Untagged:         "x",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"page":               {"2"},
+		"billing_account_id": {"123"},
+		"status":             {""},
+		"uuid":               {id.String()},
+		"start_time":         {"2024-01-01T00:00:00Z"},
+		"date":               {"2024-01-02"},
+		"step":               {"300"},
+		"metrics":            {"cpu,ram"},
+		"id":                 {"1", "2"},
+		"enabled":            {"true"},
+		"ratio":              {"0.5"},
+	}, q)
+}
+
+func TestValues_OmitEmpty(t *testing.T) {
+	q, err := Values(options{})
+	assert.NoError(t, err)
+	assert.Equal(t, url.Values{"status": {""}}, q)
+
+	q, err = Values((*options)(nil))
+	assert.NoError(t, err)
+	assert.Empty(t, q)
+}
+
+func TestValues_Invalid(t *testing.T) {
+	_, err := Values("status")
+	assert.EqualError(t, err, "Value with type of string is invalid, must be struct")
+
+	_, err = Values(struct {
+		M map[string]string `qs:"m"`
+	}{M: map[string]string{"a": "b"}})
+	assert.EqualError(t, err, "M: type map[string]string is not supported")
+}
@@ -0,0 +1,295 @@
+package warren
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/ekaputra07/warren-go/account"
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/billing"
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/ekaputra07/warren-go/bulk"
+	"github.com/ekaputra07/warren-go/dns"
+	"github.com/ekaputra07/warren-go/events"
+	"github.com/ekaputra07/warren-go/firewall"
+	"github.com/ekaputra07/warren-go/image"
+	"github.com/ekaputra07/warren-go/ip"
+	"github.com/ekaputra07/warren-go/kubernetes"
+	"github.com/ekaputra07/warren-go/lb"
+	"github.com/ekaputra07/warren-go/location"
+	"github.com/ekaputra07/warren-go/objectstorage"
+	"github.com/ekaputra07/warren-go/sshkey"
+	"github.com/ekaputra07/warren-go/token"
+	"github.com/ekaputra07/warren-go/vm"
+	"github.com/ekaputra07/warren-go/vpc"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/ekaputra07/warren-go/webhook"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=service.go -destination=mocks/service.go -package=mocks
+
+// Service interfaces below are implemented by the module clients,
+// depend on them instead of the concrete clients so they can be replaced by `mocks` in tests.
+var (
+	_ LocationService      = (*location.Client)(nil)
+	_ ObjectStorageService = (*objectstorage.Client)(nil)
+	_ DiskService          = (*blockstorage.Client)(nil)
+	_ VPCService           = (*vpc.Client)(nil)
+	_ FloatingIPService    = (*ip.Client)(nil)
+	_ VMService            = (*vm.Client)(nil)
+	_ LoadBalancerService  = (*lb.Client)(nil)
+	_ KubernetesService    = (*kubernetes.Client)(nil)
+	_ BillingService       = (*billing.Client)(nil)
+	_ SSHKeyService        = (*sshkey.Client)(nil)
+	_ ImageService         = (*image.Client)(nil)
+	_ TokenService         = (*token.Client)(nil)
+	_ AccountService       = (*account.Client)(nil)
+	_ EventsService        = (*events.Client)(nil)
+	_ WebhookService       = (*webhook.Client)(nil)
+	_ FirewallService      = (*firewall.Client)(nil)
+	_ DNSService           = (*dns.Client)(nil)
+)
+
+// LocationService is implemented by `location.Client`.
+type LocationService interface {
+	ListLocations(ctx context.Context) (*[]location.Location, error)
+	GetLocation(ctx context.Context, slug string) (*location.Location, error)
+	GetDefaultLocation(ctx context.Context) (*location.Location, error)
+}
+
+// ObjectStorageService is implemented by `objectstorage.Client`.
+type ObjectStorageService interface {
+	GetS3ApiURL(ctx context.Context) (*map[string]string, error)
+	GetS3UserInfo(ctx context.Context) (*objectstorage.S3UserInfo, error)
+	GetS3UserKeys(ctx context.Context) (*[]objectstorage.S3Credential, error)
+	GenerateS3UserKey(ctx context.Context) (*[]objectstorage.S3Credential, error)
+	DeleteS3UserKey(ctx context.Context, accessKey string) error
+	ListBuckets(ctx context.Context) (*[]objectstorage.S3Bucket, error)
+	ListBucketsIterator(limit int) *api.Iterator[objectstorage.S3Bucket]
+	GetBucket(ctx context.Context, bucketName string) (*objectstorage.S3Bucket, error)
+	CreateBucket(ctx context.Context, bucketName string) (*objectstorage.S3Bucket, error)
+	DeleteBucket(ctx context.Context, bucketName string) error
+	UpdateBucketBillingAccount(ctx context.Context, bucketName string, billingAccountID int) error
+}
+
+// DiskService is implemented by `blockstorage.Client`.
+type DiskService interface {
+	ListDisks(ctx context.Context, opts blockstorage.ListDisksOptions) (*[]blockstorage.Disk, error)
+	ListDisksIterator(limit int) *api.Iterator[blockstorage.Disk]
+	CreateDisk(ctx context.Context, cfg blockstorage.CreateDiskConfig) (*blockstorage.Disk, error)
+	CloneDisk(ctx context.Context, sourceDiskID uuid.UUID, cfg blockstorage.CreateDiskConfig) (*blockstorage.Disk, error)
+	GetDisk(ctx context.Context, diskID uuid.UUID) (*blockstorage.Disk, error)
+	DeleteDisk(ctx context.Context, diskID uuid.UUID) error
+	DeleteDisks(ctx context.Context, diskIDs []uuid.UUID, opts bulk.Options) error
+	AttachDiskToVM(ctx context.Context, diskID, vmID uuid.UUID) error
+	DetachDiskFromVM(ctx context.Context, diskID, vmID uuid.UUID) error
+	UpdateDisk(ctx context.Context, diskID uuid.UUID, cfg blockstorage.UpdateDiskConfig) (*blockstorage.Disk, error)
+	UpdateDiskBillingAccount(ctx context.Context, diskID uuid.UUID, billingAccountID int) error
+	ResizeDisk(ctx context.Context, diskID uuid.UUID, newSizeGB int) (*blockstorage.Disk, error)
+	WaitForDiskStatus(ctx context.Context, diskID uuid.UUID, status string, opts ...waiter.Option) (*blockstorage.Disk, error)
+	ListSnapshots(ctx context.Context, diskID uuid.UUID) (*[]blockstorage.Snapshot, error)
+	CreateSnapshot(ctx context.Context, diskID uuid.UUID) (*blockstorage.Snapshot, error)
+	GetSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID) (*blockstorage.Snapshot, error)
+	RestoreSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID) (*blockstorage.Disk, error)
+	DeleteSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID) error
+	CreateDiskFromSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID, cfg blockstorage.CreateDiskConfig) (*blockstorage.Disk, error)
+	WaitForSnapshot(ctx context.Context, diskID, snapshotID uuid.UUID, opts ...waiter.Option) (*blockstorage.Snapshot, error)
+}
+
+// VPCService is implemented by `vpc.Client`.
+type VPCService interface {
+	ListNetworks(ctx context.Context) (*[]vpc.NetworkInfo, error)
+	ListNetworksIterator(limit int) *api.Iterator[vpc.NetworkInfo]
+	GetNetwork(ctx context.Context, id uuid.UUID) (*vpc.NetworkInfo, error)
+	DeleteNetwork(ctx context.Context, id uuid.UUID) error
+	RenameNetwork(ctx context.Context, id uuid.UUID, newName string) error
+	GetOrCreateDefaultNetwork(ctx context.Context, name string) (*vpc.NetworkInfo, error)
+	SetDefaultNetwork(ctx context.Context, id uuid.UUID) error
+	ListIPAllocations(ctx context.Context, id uuid.UUID) (*[]vpc.IPAllocation, error)
+	ReservePrivateIP(ctx context.Context, id uuid.UUID, address string) (*vpc.IPAllocation, error)
+	ReleasePrivateIP(ctx context.Context, id uuid.UUID, address string) error
+}
+
+// FloatingIPService is implemented by `ip.Client`.
+type FloatingIPService interface {
+	ListFloatingIPs(ctx context.Context) (*[]ip.IPAddressInfo, error)
+	ListFloatingIPsIterator(limit int) *api.Iterator[ip.IPAddressInfo]
+	CreateFloatingIP(ctx context.Context, info *ip.IPAddressInfo) error
+	GetFloatingIP(ctx context.Context, address string) (*ip.IPAddressInfo, error)
+	UpdateFloatingIP(ctx context.Context, info *ip.IPAddressInfo) error
+	UpdateFloatingIPBillingAccount(ctx context.Context, address string, billingAccountID int) error
+	SetFloatingIPReverseDNS(ctx context.Context, address, hostname string) error
+	DeleteFloatingIP(ctx context.Context, address string) error
+	AssignFloatingIPToVM(ctx context.Context, address string, vmUUID uuid.UUID) error
+	UnassignFloatingIPFromVM(ctx context.Context, address string, vmUUID uuid.UUID) error
+}
+
+// VMService is implemented by `vm.Client`.
+type VMService interface {
+	ListVMs(ctx context.Context) (*[]vm.VM, error)
+	ListVMsIterator(limit int) *api.Iterator[vm.VM]
+	ListVMSummaries(ctx context.Context, opts vm.ListVMsOptions) (*[]vm.Summary, error)
+	CreateVM(ctx context.Context, cfg vm.CreateVMConfig) (*vm.VM, error)
+	CreateImageFromVM(ctx context.Context, id uuid.UUID, name string) (*image.Image, error)
+	CreateVMWithFloatingIP(ctx context.Context, cfg vm.CreateVMConfig, address string, opts ...waiter.Option) (*vm.VM, *ip.IPAddressInfo, error)
+	GetVM(ctx context.Context, id uuid.UUID) (*vm.VM, error)
+	DeleteVM(ctx context.Context, id uuid.UUID) error
+	DeleteVMWithOptions(ctx context.Context, id uuid.UUID, opts vm.DeleteVMOptions, waitOpts ...waiter.Option) error
+	DeleteVMs(ctx context.Context, ids []uuid.UUID, opts bulk.Options) error
+	StartVM(ctx context.Context, id uuid.UUID) (*vm.VM, error)
+	StopVM(ctx context.Context, id uuid.UUID) (*vm.VM, error)
+	PowerOffVM(ctx context.Context, id uuid.UUID) (*vm.VM, error)
+	ShutdownVM(ctx context.Context, id uuid.UUID, timeout time.Duration, opts ...waiter.Option) (*vm.VM, error)
+	RebootVM(ctx context.Context, id uuid.UUID) (*vm.VM, error)
+	ModifyVM(ctx context.Context, id uuid.UUID, cfg vm.ModifyVMConfig) (*vm.VM, error)
+	RenameVM(ctx context.Context, id uuid.UUID, name string) (*vm.VM, error)
+	UpdateVMDescription(ctx context.Context, id uuid.UUID, description string) (*vm.VM, error)
+	UpdateVMMetadata(ctx context.Context, id uuid.UUID, metadata map[string]string) (*vm.VM, error)
+	GetTags(ctx context.Context, id uuid.UUID) (map[string]string, error)
+	SetTags(ctx context.Context, id uuid.UUID, tags map[string]string) (*vm.VM, error)
+	ResetVMPassword(ctx context.Context, id uuid.UUID, newPassword string) error
+	CloneVM(ctx context.Context, id uuid.UUID, newName string) (*vm.VM, error)
+	GetVMConsole(ctx context.Context, id uuid.UUID) (*vm.Console, error)
+	GetVMConsoleURL(ctx context.Context, id uuid.UUID) (string, error)
+	GetVMMetrics(ctx context.Context, id uuid.UUID, opts vm.MetricsOptions) (*[]vm.MetricSeries, error)
+	ListVMNetworkInterfaces(ctx context.Context, id uuid.UUID) (*[]vm.NetworkInterface, error)
+	AttachVMToNetwork(ctx context.Context, id, networkID uuid.UUID, privateIPv4 string) (*vm.NetworkInterface, error)
+	DetachVMFromNetwork(ctx context.Context, id, networkID uuid.UUID) error
+	WaitForVMStatus(ctx context.Context, id uuid.UUID, status string, opts ...waiter.Option) (*vm.VM, error)
+	WaitForVMDeleted(ctx context.Context, id uuid.UUID, opts ...waiter.Option) error
+	EnableVMBackup(ctx context.Context, id uuid.UUID) (*vm.VM, error)
+	DisableVMBackup(ctx context.Context, id uuid.UUID) (*vm.VM, error)
+	ListVMBackups(ctx context.Context, id uuid.UUID) (*[]vm.Backup, error)
+	RestoreVMBackup(ctx context.Context, id, backupID uuid.UUID) (*vm.VM, error)
+	DeleteVMBackup(ctx context.Context, id, backupID uuid.UUID) error
+	WaitForVMRestore(ctx context.Context, id uuid.UUID, opts ...waiter.Option) (*vm.VM, error)
+	ListVMDisks(ctx context.Context, id uuid.UUID) (*[]blockstorage.Disk, error)
+	AttachDisk(ctx context.Context, id, diskID uuid.UUID) error
+	DetachDisk(ctx context.Context, id, diskID uuid.UUID) error
+	AttachDiskAndWait(ctx context.Context, id, diskID uuid.UUID, opts ...waiter.Option) (*vm.Attachment, error)
+	DetachDiskAndWait(ctx context.Context, id, diskID uuid.UUID, opts ...waiter.Option) error
+	ListISOs(ctx context.Context) (*[]vm.ISO, error)
+	AttachISO(ctx context.Context, id uuid.UUID, isoID string) (*vm.VM, error)
+	DetachISO(ctx context.Context, id uuid.UUID) (*vm.VM, error)
+}
+
+// LoadBalancerService is implemented by `lb.Client`.
+type LoadBalancerService interface {
+	ListLoadBalancers(ctx context.Context) (*[]lb.LoadBalancer, error)
+	ListLoadBalancersIterator(limit int) *api.Iterator[lb.LoadBalancer]
+	CreateLoadBalancer(ctx context.Context, cfg lb.CreateLoadBalancerConfig) (*lb.LoadBalancer, error)
+	GetLoadBalancer(ctx context.Context, id uuid.UUID) (*lb.LoadBalancer, error)
+	DeleteLoadBalancer(ctx context.Context, id uuid.UUID) error
+	UpdateHealthCheck(ctx context.Context, id uuid.UUID, hc lb.HealthCheck) (*lb.LoadBalancer, error)
+	AddForwardingRule(ctx context.Context, id uuid.UUID, cfg lb.ForwardingRuleConfig) (*lb.ForwardingRule, error)
+	DeleteForwardingRule(ctx context.Context, id, ruleID uuid.UUID) error
+	AddTarget(ctx context.Context, id uuid.UUID, cfg lb.TargetConfig) (*lb.Target, error)
+	RemoveTarget(ctx context.Context, id, targetID uuid.UUID) error
+	EnsureTargets(ctx context.Context, id uuid.UUID, vmIDs []uuid.UUID) (*[]lb.Target, error)
+	ReplaceTargets(ctx context.Context, id uuid.UUID, vmIDs []uuid.UUID) (*[]lb.Target, error)
+}
+
+// KubernetesService is implemented by `kubernetes.Client`.
+type KubernetesService interface {
+	ListClusters(ctx context.Context) (*[]kubernetes.Cluster, error)
+	ListClustersIterator(limit int) *api.Iterator[kubernetes.Cluster]
+	CreateCluster(ctx context.Context, cfg kubernetes.CreateClusterConfig) (*kubernetes.Cluster, error)
+	GetCluster(ctx context.Context, id uuid.UUID) (*kubernetes.Cluster, error)
+	DeleteCluster(ctx context.Context, id uuid.UUID) error
+	GetKubeconfig(ctx context.Context, id uuid.UUID) ([]byte, error)
+	WriteKubeconfig(ctx context.Context, id uuid.UUID, path string) error
+	ListNodePools(ctx context.Context, id uuid.UUID) (*[]kubernetes.NodePool, error)
+	CreateNodePool(ctx context.Context, id uuid.UUID, cfg kubernetes.NodePoolConfig) (*kubernetes.NodePool, error)
+	GetNodePool(ctx context.Context, id, poolID uuid.UUID) (*kubernetes.NodePool, error)
+	ScaleNodePool(ctx context.Context, id, poolID uuid.UUID, nodeCount int) (*kubernetes.NodePool, error)
+	DeleteNodePool(ctx context.Context, id, poolID uuid.UUID) error
+	WaitForNodePool(ctx context.Context, id, poolID uuid.UUID, opts ...waiter.Option) (*kubernetes.NodePool, error)
+	WaitForNodePoolDeleted(ctx context.Context, id, poolID uuid.UUID, opts ...waiter.Option) error
+}
+
+// BillingService is implemented by `billing.Client`.
+type BillingService interface {
+	ListBillingAccounts(ctx context.Context) (*[]billing.BillingAccount, error)
+	ListBillingAccountsIterator(limit int) *api.Iterator[billing.BillingAccount]
+	GetBillingAccount(ctx context.Context, id int) (*billing.BillingAccount, error)
+	GetDefaultBillingAccount(ctx context.Context) (*billing.BillingAccount, error)
+	GetLimits(ctx context.Context, id int) (*billing.Limits, error)
+	GetOngoingUsage(ctx context.Context, id int) (*billing.Usage, error)
+	GetBalance(ctx context.Context, id int) (*billing.Balance, error)
+	CheckBalance(ctx context.Context, id int, min float64) (*billing.Balance, error)
+	ListInvoices(ctx context.Context, id int) (*[]billing.Invoice, error)
+	GetInvoice(ctx context.Context, invoiceID int) (*billing.Invoice, error)
+	DownloadInvoicePDF(ctx context.Context, invoiceID int, w io.Writer) error
+	GetUsageReport(ctx context.Context, id int, from, to time.Time) (*billing.UsageReport, error)
+}
+
+// SSHKeyService is implemented by `sshkey.Client`.
+type SSHKeyService interface {
+	ListSSHKeys(ctx context.Context) (*[]sshkey.SSHKey, error)
+	AddSSHKey(ctx context.Context, name, publicKey string) (*sshkey.SSHKey, error)
+	DeleteSSHKey(ctx context.Context, id int) error
+}
+
+// ImageService is implemented by `image.Client`.
+type ImageService interface {
+	ListImages(ctx context.Context) (*[]image.Image, error)
+	ListOSImages(ctx context.Context) (*[]image.Image, error)
+	FindImage(ctx context.Context, name, constraint string) (*image.Image, error)
+	CreateImageFromDisk(ctx context.Context, diskID uuid.UUID, name string) (*image.Image, error)
+	DeleteImage(ctx context.Context, id string) error
+	ListDiskImages(ctx context.Context) (*[]image.Image, error)
+}
+
+// TokenService is implemented by `token.Client`.
+type TokenService interface {
+	ListTokens(ctx context.Context) (*[]token.Token, error)
+	CreateToken(ctx context.Context, cfg token.CreateTokenConfig) (*token.Token, error)
+	RevokeToken(ctx context.Context, id int) error
+}
+
+// AccountService is implemented by `account.Client`.
+type AccountService interface {
+	GetProfile(ctx context.Context) (*account.Profile, error)
+	ListTeamMembers(ctx context.Context) (*[]account.TeamMember, error)
+	InviteTeamMember(ctx context.Context, cfg account.InviteConfig) (*account.TeamMember, error)
+	RemoveTeamMember(ctx context.Context, id int) error
+}
+
+// EventsService is implemented by `events.Client`.
+type EventsService interface {
+	ListEvents(ctx context.Context, opts events.ListEventsOptions) (*[]events.Event, error)
+	ListEventsIterator(opts events.ListEventsOptions, limit int) *api.Iterator[events.Event]
+}
+
+// WebhookService is implemented by `webhook.Client`.
+type WebhookService interface {
+	ListWebhooks(ctx context.Context) (*[]webhook.Webhook, error)
+	CreateWebhook(ctx context.Context, cfg webhook.CreateWebhookConfig) (*webhook.Webhook, error)
+	DeleteWebhook(ctx context.Context, id int) error
+	GetNotificationPreferences(ctx context.Context) (*webhook.NotificationPreferences, error)
+	UpdateNotificationPreferences(ctx context.Context, prefs webhook.NotificationPreferences) (*webhook.NotificationPreferences, error)
+}
+
+// FirewallService is implemented by `firewall.Client`.
+type FirewallService interface {
+	ListRules(ctx context.Context, scope firewall.Scope) (*[]firewall.Rule, error)
+	CreateRule(ctx context.Context, scope firewall.Scope, cfg firewall.RuleConfig) (*firewall.Rule, error)
+	DeleteRule(ctx context.Context, id uuid.UUID) error
+	SyncRules(ctx context.Context, scope firewall.Scope, desired []firewall.RuleConfig) (*[]firewall.Rule, error)
+}
+
+// DNSService is implemented by `dns.Client`.
+type DNSService interface {
+	ListZones(ctx context.Context) (*[]dns.Zone, error)
+	GetZone(ctx context.Context, id uuid.UUID) (*dns.Zone, error)
+	FindZone(ctx context.Context, fqdn string) (*dns.Zone, error)
+	CreateZone(ctx context.Context, name string) (*dns.Zone, error)
+	DeleteZone(ctx context.Context, id uuid.UUID) error
+	ListRecords(ctx context.Context, zoneID uuid.UUID) (*[]dns.Record, error)
+	CreateRecord(ctx context.Context, zoneID uuid.UUID, cfg dns.RecordConfig) (*dns.Record, error)
+	UpdateRecord(ctx context.Context, zoneID, recordID uuid.UUID, cfg dns.RecordConfig) (*dns.Record, error)
+	DeleteRecord(ctx context.Context, zoneID, recordID uuid.UUID) error
+	UpsertRecords(ctx context.Context, zoneID uuid.UUID, records []dns.RecordConfig) (*[]dns.Record, error)
+}
@@ -0,0 +1,55 @@
+package sshkey
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+func NewClient(client *api.API) *Client {
+	return &Client{
+		API: client,
+	}
+}
+
+// ListSSHKeys https://api.warren.io/#list-ssh-keys
+func (c *Client) ListSSHKeys(ctx context.Context) (*[]SSHKey, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/user-resource/user/ssh_keys",
+	}
+	var keys []SSHKey
+	if err := c.API.FormRequest(ctx, rc).Into(&keys); err != nil {
+		return nil, err
+	}
+	return &keys, nil
+}
+
+// AddSSHKey https://api.warren.io/#add-ssh-key
+func (c *Client) AddSSHKey(ctx context.Context, name, publicKey string) (*SSHKey, error) {
+	d := url.Values{
+		"name":       []string{name},
+		"public_key": []string{publicKey},
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   "/v1/user-resource/user/ssh_keys",
+		Data:   d,
+	}
+	var key SSHKey
+	if err := c.API.FormRequest(ctx, rc).Into(&key); err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+// DeleteSSHKey https://api.warren.io/#delete-ssh-key
+func (c *Client) DeleteSSHKey(ctx context.Context, id int) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/user-resource/user/ssh_keys/%d", id),
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
@@ -0,0 +1,51 @@
+package sshkey
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSSHKeys(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/ssh_keys", r.RequestURI)
+	})
+	defer s.Close()
+
+	k := Client{API: a}
+	k.ListSSHKeys(context.Background())
+}
+
+func TestAddSSHKey(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/ssh_keys", r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, "laptop", r.Form.Get("name"))
+		assert.Equal(t, "ssh-ed25519 AAAA user@laptop", r.Form.Get("public_key"))
+		w.Write([]byte(`{"id":1,"name":"laptop"}`))
+	})
+	defer s.Close()
+
+	k := Client{API: a}
+	key, err := k.AddSSHKey(context.Background(), "laptop", "ssh-ed25519 AAAA user@laptop")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, key.ID)
+	assert.Equal(t, "laptop", key.Name)
+}
+
+func TestDeleteSSHKey(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/ssh_keys/1", r.RequestURI)
+	})
+	defer s.Close()
+
+	k := Client{API: a}
+	k.DeleteSSHKey(context.Background(), 1)
+}
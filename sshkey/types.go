@@ -0,0 +1,16 @@
+package sshkey
+
+import "github.com/ekaputra07/warren-go/api"
+
+type Client struct {
+	API *api.API
+}
+
+// SSHKey is a public key that can be injected into VM at creation time
+type SSHKey struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	PublicKey   string `json:"public_key"`
+	Fingerprint string `json:"fingerprint"`
+	CreatedAt   string `json:"created_at"`
+}
@@ -0,0 +1,63 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+func NewClient(client *api.API) *Client {
+	return &Client{
+		API: client,
+	}
+}
+
+// ListTokens https://api.warren.io/#list-api-tokens
+func (c *Client) ListTokens(ctx context.Context) (*[]Token, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/user-resource/user/tokens",
+	}
+	var tokens []Token
+	if err := c.API.FormRequest(ctx, rc).Into(&tokens); err != nil {
+		return nil, err
+	}
+	return &tokens, nil
+}
+
+// CreateToken https://api.warren.io/#create-api-token
+// Keep the returned Token secret, it can't be retrieved again. Short-lived tokens (e.g. for a CI job) are created with ExpiresIn.
+func (c *Client) CreateToken(ctx context.Context, cfg CreateTokenConfig) (*Token, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	d := url.Values{
+		"name":   []string{cfg.Name},
+		"scopes": cfg.Scopes,
+	}
+	if cfg.ExpiresIn > 0 {
+		d.Set("expires_at", time.Now().Add(cfg.ExpiresIn).UTC().Format(time.RFC3339))
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   "/v1/user-resource/user/tokens",
+		Data:   d,
+	}
+	var token Token
+	if err := c.API.FormRequest(ctx, rc).Into(&token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// RevokeToken https://api.warren.io/#revoke-api-token
+func (c *Client) RevokeToken(ctx context.Context, id int) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/user-resource/user/tokens/%d", id),
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
@@ -0,0 +1,76 @@
+package token
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTokens(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/tokens", r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	c.ListTokens(context.Background())
+}
+
+func TestCreateToken(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/tokens", r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, "ci", r.Form.Get("name"))
+		assert.Equal(t, []string{ScopeRead, ScopeWrite}, r.Form["scopes"])
+		expiresAt, err := time.Parse(time.RFC3339, r.Form.Get("expires_at"))
+		assert.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+		w.Write([]byte(`{"id":1,"name":"ci","token":"secret"}`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	token, err := c.CreateToken(context.Background(), CreateTokenConfig{
+		Name:      "ci",
+		Scopes:    []string{ScopeRead, ScopeWrite},
+		ExpiresIn: time.Hour,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, token.ID)
+	assert.Equal(t, "secret", token.Token)
+}
+
+func TestCreateTokenConfig_Validate(t *testing.T) {
+	cfg := CreateTokenConfig{Name: "ci", Scopes: []string{ScopeRead}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Scopes = []string{"admin"}
+	assert.EqualError(t, cfg.Validate(), "Scopes with value of [admin] is invalid, must be one of read, write")
+
+	cfg.Scopes = nil
+	assert.EqualError(t, cfg.Validate(), "Scopes with value of [] is invalid, must not be empty")
+
+	cfg = CreateTokenConfig{Scopes: []string{ScopeRead}}
+	assert.EqualError(t, cfg.Validate(), `Name with value of "" is invalid, must not be empty`)
+
+	cfg = CreateTokenConfig{Name: "ci", Scopes: []string{ScopeRead}, ExpiresIn: -time.Hour}
+	assert.EqualError(t, cfg.Validate(), "ExpiresIn with value of -1h0m0s is invalid, must not be negative")
+}
+
+func TestRevokeToken(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/tokens/1", r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	c.RevokeToken(context.Background(), 1)
+}
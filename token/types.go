@@ -0,0 +1,56 @@
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+type Client struct {
+	API *api.API
+}
+
+// Token scopes
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
+
+// Token is an API token of the account, the secret Token is only returned when it's created.
+// ExpiresAt is empty for tokens that never expire.
+type Token struct {
+	ID         int      `json:"id"`
+	Name       string   `json:"name"`
+	Token      string   `json:"token"`
+	Scopes     []string `json:"scopes"`
+	ExpiresAt  string   `json:"expires_at"`
+	LastUsedAt string   `json:"last_used_at"`
+	CreatedAt  string   `json:"created_at"`
+}
+
+// CreateTokenConfig holds parameters to create a new token, zero ExpiresIn means token never expires.
+type CreateTokenConfig struct {
+	Name      string
+	Scopes    []string
+	ExpiresIn time.Duration
+}
+
+// Validate checks fields that are required by the API, it's called by `Client.CreateToken()` before sending the request.
+func (cfg CreateTokenConfig) Validate() error {
+	if cfg.Name == "" {
+		return fmt.Errorf("Name with value of %q is invalid, must not be empty", cfg.Name)
+	}
+	if len(cfg.Scopes) == 0 {
+		return fmt.Errorf("Scopes with value of %v is invalid, must not be empty", cfg.Scopes)
+	}
+	for _, s := range cfg.Scopes {
+		if s != ScopeRead && s != ScopeWrite {
+			return fmt.Errorf("Scopes with value of %v is invalid, must be one of %s, %s", cfg.Scopes, ScopeRead, ScopeWrite)
+		}
+	}
+	if cfg.ExpiresIn < 0 {
+		return fmt.Errorf("ExpiresIn with value of %v is invalid, must not be negative", cfg.ExpiresIn)
+	}
+	return nil
+}
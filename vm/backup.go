@@ -0,0 +1,93 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+)
+
+// EnableVMBackup https://api.warren.io/#enable-vm-backup
+func (c *Client) EnableVMBackup(ctx context.Context, id uuid.UUID) (*VM, error) {
+	return c.backupAction(ctx, id, "enable")
+}
+
+// DisableVMBackup https://api.warren.io/#disable-vm-backup
+func (c *Client) DisableVMBackup(ctx context.Context, id uuid.UUID) (*VM, error) {
+	return c.backupAction(ctx, id, "disable")
+}
+
+// backupAction toggles scheduled backups of VM and returns the updated VM.
+func (c *Client) backupAction(ctx context.Context, id uuid.UUID, action string) (*VM, error) {
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/backup/%s", c.Location, action),
+		Data:   url.Values{"uuid": []string{id.String()}},
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// ListVMBackups https://api.warren.io/#list-vm-backups
+func (c *Client) ListVMBackups(ctx context.Context, id uuid.UUID) (*[]Backup, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/backup/list", c.Location),
+		Query:  url.Values{"uuid": []string{id.String()}},
+	}
+	var backups []Backup
+	if err := c.API.FormRequest(ctx, rc).Into(&backups); err != nil {
+		return nil, err
+	}
+	return &backups, nil
+}
+
+// RestoreVMBackup https://api.warren.io/#restore-vm-backup
+func (c *Client) RestoreVMBackup(ctx context.Context, id, backupID uuid.UUID) (*VM, error) {
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/backup/restore", c.Location),
+		Data: url.Values{
+			"uuid":        []string{id.String()},
+			"backup_uuid": []string{backupID.String()},
+		},
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// DeleteVMBackup https://api.warren.io/#delete-vm-backup
+func (c *Client) DeleteVMBackup(ctx context.Context, id, backupID uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/backup", c.Location),
+		Data: url.Values{
+			"uuid":        []string{id.String()},
+			"backup_uuid": []string{backupID.String()},
+		},
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
+
+// WaitForVMRestore polls VM until it's no longer restoring from backup or ctx is done, and returns the latest VM.
+func (c *Client) WaitForVMRestore(ctx context.Context, id uuid.UUID, opts ...waiter.Option) (*VM, error) {
+	var vm *VM
+	err := waiter.Until(ctx, func(ctx context.Context) (bool, error) {
+		v, err := c.GetVM(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		vm = v
+		return v.Status != StatusRestoring, nil
+	}, opts...)
+	return vm, err
+}
@@ -0,0 +1,117 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var backupID uuid.UUID = uuid.MustParse("0b7c5a52-6c3a-4f4e-8a55-6f1d2e3c4b5a")
+
+func TestEnableVMBackup(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/backup/enable", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		w.Write([]byte(`{"backup":true}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	v, err := vm.EnableVMBackup(context.Background(), id)
+	assert.NoError(t, err)
+	assert.True(t, v.Backup)
+}
+
+func TestDisableVMBackup(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/backup/disable", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		w.Write([]byte(`{"backup":false}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	v, err := vm.DisableVMBackup(context.Background(), id)
+	assert.NoError(t, err)
+	assert.False(t, v.Backup)
+}
+
+func TestListVMBackups(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/backup/list?uuid=%s", loc, id), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`[{"uuid":"%s","vm_uuid":"%s"}]`, backupID, id)))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	backups, err := vm.ListVMBackups(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, backupID, (*backups)[0].UUID)
+	assert.Equal(t, id, (*backups)[0].VMUUID)
+}
+
+func TestRestoreVMBackup(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/backup/restore", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, backupID.String(), r.Form.Get("backup_uuid"))
+		w.Write([]byte(`{"status":"restoring"}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	v, err := vm.RestoreVMBackup(context.Background(), id, backupID)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusRestoring, v.Status)
+}
+
+func TestDeleteVMBackup(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/backup", loc), r.RequestURI)
+
+		// ParseForm() ignores DELETE body
+		b, _ := io.ReadAll(r.Body)
+		d, _ := url.ParseQuery(string(b))
+		assert.Equal(t, id.String(), d.Get("uuid"))
+		assert.Equal(t, backupID.String(), d.Get("backup_uuid"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.DeleteVMBackup(context.Background(), id, backupID)
+}
+
+func TestWaitForVMRestore(t *testing.T) {
+	statuses := []string{StatusRestoring, StatusStopped}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm?uuid=%s", loc, id), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, id, statuses[0])))
+		statuses = statuses[1:]
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	v, err := vm.WaitForVMRestore(context.Background(), id, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, StatusStopped, v.Status)
+}
@@ -0,0 +1,77 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+)
+
+// DeleteVMWithOptions deletes VM and, depending on opts, the resources attached to it.
+// `*PreconditionError` is returned when RequireStopped is set and VM is not stopped.
+//
+// VM deletion is asynchronous, attached resources are deleted only after VM is gone (see `WaitForVMDeleted()`,
+// waitOpts control the polling) since they can't be deleted while still attached. Failure to delete any of them
+// doesn't stop the others and all errors are returned joined.
+func (c *Client) DeleteVMWithOptions(ctx context.Context, id uuid.UUID, opts DeleteVMOptions, waitOpts ...waiter.Option) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	var disks []uuid.UUID
+	if opts.RequireStopped || opts.DeleteDisks {
+		vm, err := c.GetVM(ctx, id)
+		if err != nil {
+			return err
+		}
+		if opts.RequireStopped && vm.Status != StatusStopped {
+			return &PreconditionError{VMUUID: id, Status: vm.Status, Required: StatusStopped}
+		}
+		for _, s := range vm.Storage {
+			if !s.Primary {
+				disks = append(disks, s.UUID)
+			}
+		}
+	}
+
+	var addresses []string
+	if opts.DeleteFloatingIPs {
+		ips, err := c.floatingIPs().ListFloatingIPs(ctx)
+		if err != nil {
+			return err
+		}
+		for _, info := range *ips {
+			if a := info.Assignment(); a != nil && a.ResourceUUID == id {
+				addresses = append(addresses, info.Address)
+			}
+		}
+	}
+
+	if err := c.deleteVM(ctx, id, opts.Force); err != nil {
+		return err
+	}
+	if len(addresses) == 0 && len(disks) == 0 {
+		return nil
+	}
+	if err := c.WaitForVMDeleted(ctx, id, waitOpts...); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, address := range addresses {
+		if err := c.floatingIPs().DeleteFloatingIP(ctx, address); err != nil {
+			errs = append(errs, fmt.Errorf("delete floating IP %s: %w", address, err))
+		}
+	}
+	for _, diskID := range disks {
+		if err := c.disks().DeleteDisk(ctx, diskID); err != nil {
+			errs = append(errs, fmt.Errorf("delete disk %s: %w", diskID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,133 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteVMOptions_Validate(t *testing.T) {
+	assert.NoError(t, DeleteVMOptions{}.Validate())
+	assert.NoError(t, DeleteVMOptions{DeleteDisks: true, Force: true}.Validate())
+	assert.EqualError(t, DeleteVMOptions{Force: true, RequireStopped: true}.Validate(), "Force and RequireStopped with value of true true is invalid, only one can be set")
+}
+
+func TestDeleteVMWithOptions(t *testing.T) {
+	primaryID := uuid.New()
+	extraID := uuid.New()
+	var calls []string
+	deleted := false
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == "GET" && r.URL.Path == fmt.Sprintf("/v1/%s/user-resource/vm", loc) && deleted:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == "GET" && r.URL.Path == fmt.Sprintf("/v1/%s/user-resource/vm", loc):
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"stopped","storage":[{"uuid":"%s","primary":true},{"uuid":"%s"}]}`, id, primaryID, extraID)))
+		case r.Method == "GET":
+			w.Write([]byte(fmt.Sprintf(`[{"address":"1.2.3.4","assigned_to":"%s"},{"address":"5.6.7.8","assigned_to":"%s"},{"address":"9.9.9.9"}]`, id, uuid.New())))
+		case r.URL.Path == fmt.Sprintf("/v1/%s/user-resource/vm", loc):
+			b, _ := io.ReadAll(r.Body)
+			d, _ := url.ParseQuery(string(b))
+			assert.Equal(t, id.String(), d.Get("uuid"))
+			assert.NotContains(t, d, "force")
+			deleted = true
+		}
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	err := vm.DeleteVMWithOptions(context.Background(), id, DeleteVMOptions{DeleteFloatingIPs: true, DeleteDisks: true, RequireStopped: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		fmt.Sprintf("GET /v1/%s/user-resource/vm", loc),
+		fmt.Sprintf("GET /v1/%s/network/ip_addresses", loc),
+		fmt.Sprintf("DELETE /v1/%s/user-resource/vm", loc),
+		// attached resources are deleted once VM is gone
+		fmt.Sprintf("GET /v1/%s/user-resource/vm", loc),
+		fmt.Sprintf("DELETE /v1/%s/network/ip_addresses/1.2.3.4", loc),
+		fmt.Sprintf("DELETE /v1/storage/disks/%s", extraID),
+	}, calls)
+}
+
+func TestDeleteVMWithOptions_NotStopped(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		// nothing is deleted
+		assert.Equal(t, "GET", r.Method)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"running"}`, id)))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	err := vm.DeleteVMWithOptions(context.Background(), id, DeleteVMOptions{DeleteDisks: true, RequireStopped: true})
+
+	var pe *PreconditionError
+	assert.True(t, errors.As(err, &pe))
+	assert.Equal(t, StatusRunning, pe.Status)
+	assert.EqualError(t, err, fmt.Sprintf("vm %s is running, must be stopped", id))
+}
+
+func TestDeleteVMWithOptions_Force(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		b, _ := io.ReadAll(r.Body)
+		d, _ := url.ParseQuery(string(b))
+		assert.Equal(t, "true", d.Get("force"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	assert.NoError(t, vm.DeleteVMWithOptions(context.Background(), id, DeleteVMOptions{Force: true}))
+}
+
+func TestDeleteVMWithOptions_CascadeFailed(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == fmt.Sprintf("/v1/%s/user-resource/vm", loc):
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"deleted"}`, id)))
+		case r.Method == "GET":
+			w.Write([]byte(fmt.Sprintf(`[{"address":"1.2.3.4","assigned_to":"%s"}]`, id)))
+		case r.URL.Path == fmt.Sprintf("/v1/%s/network/ip_addresses/1.2.3.4", loc):
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	err := vm.DeleteVMWithOptions(context.Background(), id, DeleteVMOptions{DeleteFloatingIPs: true})
+	assert.ErrorIs(t, err, api.ErrNotFound)
+	assert.Contains(t, err.Error(), "delete floating IP 1.2.3.4")
+}
+
+func TestDeleteVMWithOptions_WaitFailed(t *testing.T) {
+	var calls []string
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == "GET" && r.URL.Path == fmt.Sprintf("/v1/%s/user-resource/vm", loc):
+			// deletion never finishes
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"deleting"}`, id)))
+		case r.Method == "GET":
+			w.Write([]byte(fmt.Sprintf(`[{"address":"1.2.3.4","assigned_to":"%s"}]`, id)))
+		}
+	})
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	vm := Client{API: a, Location: loc}
+	err := vm.DeleteVMWithOptions(ctx, id, DeleteVMOptions{DeleteFloatingIPs: true}, waiter.WithInterval(time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	// floating IP is kept while VM still exists
+	assert.NotContains(t, calls, fmt.Sprintf("DELETE /v1/%s/network/ip_addresses/1.2.3.4", loc))
+}
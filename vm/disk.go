@@ -0,0 +1,101 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/ekaputra07/warren-go/image"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+)
+
+// disks returns blockstorage client sharing the same API
+func (c *Client) disks() *blockstorage.Client {
+	return blockstorage.NewClient(c.API)
+}
+
+// ListVMDisks returns disks attached to VM, each resolved to `blockstorage.Disk`.
+func (c *Client) ListVMDisks(ctx context.Context, id uuid.UUID) (*[]blockstorage.Disk, error) {
+	vm, err := c.GetVM(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	disks := []blockstorage.Disk{}
+	for _, s := range vm.Storage {
+		d, err := c.disks().GetDisk(ctx, s.UUID)
+		if err != nil {
+			return nil, err
+		}
+		disks = append(disks, *d)
+	}
+	return &disks, nil
+}
+
+// AttachDisk attaches disk to VM, see `blockstorage.Client.AttachDiskToVM()`.
+func (c *Client) AttachDisk(ctx context.Context, id, diskID uuid.UUID) error {
+	return c.disks().AttachDiskToVM(ctx, diskID, id)
+}
+
+// DetachDisk detaches disk from VM, see `blockstorage.Client.DetachDiskFromVM()`.
+func (c *Client) DetachDisk(ctx context.Context, id, diskID uuid.UUID) error {
+	return c.disks().DetachDiskFromVM(ctx, diskID, id)
+}
+
+// CreateImageFromVM creates custom image from VM's primary disk, see `image.Client.CreateImageFromDisk()`.
+func (c *Client) CreateImageFromVM(ctx context.Context, id uuid.UUID, name string) (*image.Image, error) {
+	vm, err := c.GetVM(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range vm.Storage {
+		if s.Primary {
+			return image.NewClient(c.API).CreateImageFromDisk(ctx, s.UUID, name)
+		}
+	}
+	return nil, fmt.Errorf("vm %s has no primary disk", id)
+}
+
+// attachment returns attachment of disk to VM, nil when disk is not attached.
+func (vm VM) attachment(diskID uuid.UUID) *Attachment {
+	for _, s := range vm.Storage {
+		if s.UUID == diskID {
+			return &Attachment{VMUUID: vm.UUID, DiskUUID: diskID, DevicePath: s.DevicePath, Primary: s.Primary}
+		}
+	}
+	return nil
+}
+
+// AttachDiskAndWait attaches disk to VM and polls VM until the disk shows up in its storage or ctx is done.
+func (c *Client) AttachDiskAndWait(ctx context.Context, id, diskID uuid.UUID, opts ...waiter.Option) (*Attachment, error) {
+	if err := c.AttachDisk(ctx, id, diskID); err != nil {
+		return nil, err
+	}
+	var attachment *Attachment
+	err := waiter.Until(ctx, func(ctx context.Context) (bool, error) {
+		vm, err := c.GetVM(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		attachment = vm.attachment(diskID)
+		return attachment != nil, nil
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// DetachDiskAndWait detaches disk from VM and polls VM until the disk is gone from its storage or ctx is done.
+func (c *Client) DetachDiskAndWait(ctx context.Context, id, diskID uuid.UUID, opts ...waiter.Option) error {
+	if err := c.DetachDisk(ctx, id, diskID); err != nil {
+		return err
+	}
+	return waiter.Until(ctx, func(ctx context.Context) (bool, error) {
+		vm, err := c.GetVM(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return vm.attachment(diskID) == nil, nil
+	}, opts...)
+}
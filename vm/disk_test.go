@@ -0,0 +1,163 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var diskID uuid.UUID = uuid.MustParse("8d2d4e3a-5c86-4a4e-9d5b-0b3c4a0f2c11")
+
+func TestListVMDisks(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		switch r.URL.Path {
+		case fmt.Sprintf("/v1/%s/user-resource/vm", loc):
+			fmt.Fprintf(w, `{"uuid":"%s","storage":[{"uuid":"%s","primary":true}]}`, id, diskID)
+		case fmt.Sprintf("/v1/storage/disks/%s", diskID):
+			fmt.Fprintf(w, `{"uuid":"%s","status":"Active","size_gb":20}`, diskID)
+		default:
+			t.Errorf("unexpected request %s", r.RequestURI)
+		}
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	disks, err := vm.ListVMDisks(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Len(t, *disks, 1)
+	assert.Equal(t, diskID, (*disks)[0].UUID)
+	assert.Equal(t, 20, (*disks)[0].SizeGB)
+}
+
+func TestListVMDisks_Error(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == fmt.Sprintf("/v1/storage/disks/%s", diskID) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"uuid":"%s","storage":[{"uuid":"%s"}]}`, id, diskID)
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	disks, err := vm.ListVMDisks(context.Background(), id)
+	assert.Nil(t, disks)
+	assert.ErrorIs(t, err, api.ErrNotFound)
+}
+
+func TestAttachDisk(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/user-resource/vm/storage/attach", r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, diskID.String(), r.Form.Get("storage_uuid"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	assert.NoError(t, vm.AttachDisk(context.Background(), id, diskID))
+}
+
+func TestDetachDisk(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/user-resource/vm/storage/detach", r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, diskID.String(), r.Form.Get("storage_uuid"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	assert.NoError(t, vm.DetachDisk(context.Background(), id, diskID))
+}
+
+func TestAttachDiskAndWait(t *testing.T) {
+	polls := 0
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			assert.Equal(t, "/v1/user-resource/vm/storage/attach", r.RequestURI)
+			return
+		}
+		polls++
+		if polls < 2 {
+			fmt.Fprintf(w, `{"uuid":"%s","storage":[]}`, id)
+			return
+		}
+		fmt.Fprintf(w, `{"uuid":"%s","storage":[{"uuid":"%s","device_path":"/dev/vdb"}]}`, id, diskID)
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	attachment, err := vm.AttachDiskAndWait(context.Background(), id, diskID, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, &Attachment{VMUUID: id, DiskUUID: diskID, DevicePath: "/dev/vdb"}, attachment)
+	assert.Equal(t, 2, polls)
+}
+
+func TestAttachDiskAndWait_Error(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	attachment, err := vm.AttachDiskAndWait(context.Background(), id, diskID)
+	assert.Nil(t, attachment)
+	assert.ErrorIs(t, err, api.ErrBadRequest)
+}
+
+func TestDetachDiskAndWait(t *testing.T) {
+	polls := 0
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			assert.Equal(t, "/v1/user-resource/vm/storage/detach", r.RequestURI)
+			return
+		}
+		polls++
+		if polls < 2 {
+			fmt.Fprintf(w, `{"uuid":"%s","storage":[{"uuid":"%s"}]}`, id, diskID)
+			return
+		}
+		fmt.Fprintf(w, `{"uuid":"%s","storage":[]}`, id)
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	err := vm.DetachDiskAndWait(context.Background(), id, diskID, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, polls)
+}
+
+func TestCreateImageFromVM(t *testing.T) {
+	primaryID := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","storage":[{"uuid":"%s"},{"uuid":"%s","primary":true}]}`, id, uuid.New(), primaryID)))
+			return
+		}
+		assert.Equal(t, "/v1/storage/images", r.RequestURI)
+		_ = r.ParseForm()
+		assert.Equal(t, primaryID.String(), r.Form.Get("disk_uuid"))
+		assert.Equal(t, "web-template", r.Form.Get("name"))
+		w.Write([]byte(`{"id":"5f0c","type":"DISK"}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	img, err := vm.CreateImageFromVM(context.Background(), id, "web-template")
+	assert.NoError(t, err)
+	assert.Equal(t, "5f0c", img.ID)
+}
@@ -0,0 +1,67 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/ip"
+	"github.com/ekaputra07/warren-go/waiter"
+)
+
+// floatingIPs returns floating IP client sharing the same API and location
+func (c *Client) floatingIPs() *ip.Client {
+	return ip.NewClient(c.API, c.Location)
+}
+
+// CreateVMWithFloatingIP creates VM, waits until it's running and assigns floating IP to it.
+// Existing floating IP is used when address is set, it must not be assigned to other resource,
+// otherwise a new one is created with the same name and billing account as the VM.
+//
+// The API can't do it in one call, so when anything fails after the VM is created
+// the VM, and the floating IP when it was created by this call, are returned along with the error
+// and it's up to the caller to delete them or retry the assignment.
+func (c *Client) CreateVMWithFloatingIP(ctx context.Context, cfg CreateVMConfig, address string, opts ...waiter.Option) (*VM, *ip.IPAddressInfo, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+	// check existing address before creating anything
+	if address != "" {
+		info, err := c.floatingIPs().GetFloatingIP(ctx, address)
+		if err != nil {
+			return nil, nil, err
+		}
+		if info.IsAssigned() {
+			return nil, nil, fmt.Errorf("Address with value of %v is invalid, already assigned to %s", address, info.AssignedTo.UUID)
+		}
+	}
+
+	created, err := c.CreateVM(ctx, cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	vm, err := c.WaitForVMStatus(ctx, created.UUID, StatusRunning, opts...)
+	if err != nil {
+		return created, nil, err
+	}
+
+	// floating IP created here is returned even on failure, it's billed until the caller deletes it
+	var newIP *ip.IPAddressInfo
+	if address == "" {
+		newIP = &ip.IPAddressInfo{Name: cfg.Name, BillingAccountID: cfg.BillingAccountID}
+		if err := c.floatingIPs().CreateFloatingIP(ctx, newIP); err != nil {
+			return vm, nil, err
+		}
+		address = newIP.Address
+	}
+	if err := c.floatingIPs().AssignFloatingIPToVM(ctx, address, vm.UUID); err != nil {
+		return vm, newIP, err
+	}
+	info, err := c.floatingIPs().GetFloatingIP(ctx, address)
+	if err != nil {
+		return vm, newIP, err
+	}
+	return vm, info, nil
+}
@@ -0,0 +1,134 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/stretchr/testify/assert"
+)
+
+func floatingIPConfig() CreateVMConfig {
+	return CreateVMConfig{
+		Name:             "web",
+		OSName:           "ubuntu",
+		OSVersion:        "22.04",
+		VCPU:             2,
+		RAM:              2048,
+		Disks:            20,
+		Username:         "admin",
+		SSHKeyName:       "laptop",
+		BillingAccountID: 123,
+	}
+}
+
+func TestCreateVMWithFloatingIP(t *testing.T) {
+	var calls []string
+	assigned := false
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case fmt.Sprintf("/v1/%s/user-resource/vm", loc):
+			status := StatusRunning
+			if r.Method == "POST" {
+				status = StatusCreating
+			}
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, id, status)))
+		case fmt.Sprintf("/v1/%s/network/ip_addresses", loc):
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, "web", body["name"])
+			assert.Equal(t, float64(123), body["billing_account_id"])
+			w.Write([]byte(`{"address":"1.2.3.4"}`))
+		case fmt.Sprintf("/v1/%s/network/ip_addresses/1.2.3.4/assign", loc):
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, id.String(), body["vm_uuid"])
+			assigned = true
+		case fmt.Sprintf("/v1/%s/network/ip_addresses/1.2.3.4", loc):
+			if assigned {
+				w.Write([]byte(fmt.Sprintf(`{"address":"1.2.3.4","assigned_to":"%s"}`, id)))
+				return
+			}
+			w.Write([]byte(`{"address":"1.2.3.4"}`))
+		}
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	created, info, err := vm.CreateVMWithFloatingIP(context.Background(), floatingIPConfig(), "", waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, StatusRunning, created.Status)
+	assert.Equal(t, "1.2.3.4", info.Address)
+	assert.Equal(t, id, info.AssignedTo.UUID)
+	assert.Equal(t, []string{
+		fmt.Sprintf("POST /v1/%s/user-resource/vm", loc),
+		fmt.Sprintf("GET /v1/%s/user-resource/vm", loc),
+		fmt.Sprintf("POST /v1/%s/network/ip_addresses", loc),
+		fmt.Sprintf("POST /v1/%s/network/ip_addresses/1.2.3.4/assign", loc),
+		fmt.Sprintf("GET /v1/%s/network/ip_addresses/1.2.3.4", loc),
+	}, calls)
+}
+
+func TestCreateVMWithFloatingIP_AlreadyAssigned(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		// VM must not be created
+		assert.Equal(t, "GET", r.Method)
+		w.Write([]byte(fmt.Sprintf(`{"address":"1.2.3.4","assigned_to":"%s"}`, id)))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	created, info, err := vm.CreateVMWithFloatingIP(context.Background(), floatingIPConfig(), "1.2.3.4")
+	assert.Nil(t, created)
+	assert.Nil(t, info)
+	assert.EqualError(t, err, fmt.Sprintf("Address with value of 1.2.3.4 is invalid, already assigned to %s", id))
+}
+
+func TestCreateVMWithFloatingIP_AssignFailed(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case fmt.Sprintf("/v1/%s/network/ip_addresses/1.2.3.4", loc):
+			w.Write([]byte(`{"address":"1.2.3.4"}`))
+		case fmt.Sprintf("/v1/%s/network/ip_addresses/1.2.3.4/assign", loc):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"running"}`, id)))
+		}
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	created, info, err := vm.CreateVMWithFloatingIP(context.Background(), floatingIPConfig(), "1.2.3.4")
+	assert.ErrorIs(t, err, api.ErrConflict)
+	assert.Nil(t, info)
+	// VM is returned so caller can clean it up
+	assert.Equal(t, id, created.UUID)
+}
+
+func TestCreateVMWithFloatingIP_NewIPAssignFailed(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case fmt.Sprintf("/v1/%s/network/ip_addresses", loc):
+			w.Write([]byte(`{"address":"1.2.3.4"}`))
+		case fmt.Sprintf("/v1/%s/network/ip_addresses/1.2.3.4/assign", loc):
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"running"}`, id)))
+		}
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	created, info, err := vm.CreateVMWithFloatingIP(context.Background(), floatingIPConfig(), "")
+	assert.ErrorIs(t, err, api.ErrConflict)
+	assert.Equal(t, id, created.UUID)
+	// created floating IP is returned so caller can clean it up
+	assert.Equal(t, "1.2.3.4", info.Address)
+	assert.False(t, info.IsAssigned())
+}
@@ -0,0 +1,48 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+// ListISOs https://api.warren.io/#list-isos
+func (c *Client) ListISOs(ctx context.Context) (*[]ISO, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/iso/list", c.Location),
+	}
+	var isos []ISO
+	if err := c.API.FormRequest(ctx, rc).Into(&isos); err != nil {
+		return nil, err
+	}
+	return &isos, nil
+}
+
+// AttachISO https://api.warren.io/#attach-iso
+// VM boots from the ISO on next start, use it for rescue or installer workflows.
+func (c *Client) AttachISO(ctx context.Context, id uuid.UUID, isoID string) (*VM, error) {
+	return c.isoAction(ctx, id, "attach", url.Values{"iso_id": []string{isoID}})
+}
+
+// DetachISO https://api.warren.io/#detach-iso
+func (c *Client) DetachISO(ctx context.Context, id uuid.UUID) (*VM, error) {
+	return c.isoAction(ctx, id, "detach", url.Values{})
+}
+
+func (c *Client) isoAction(ctx context.Context, id uuid.UUID, action string, d url.Values) (*VM, error) {
+	d.Set("uuid", id.String())
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/iso/%s", c.Location, action),
+		Data:   d,
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
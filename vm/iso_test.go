@@ -0,0 +1,55 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListISOs(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/iso/list", loc), r.RequestURI)
+		w.Write([]byte(`[{"id":"systemrescue-10","name":"SystemRescue 10"}]`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	isos, err := vm.ListISOs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "systemrescue-10", (*isos)[0].ID)
+}
+
+func TestAttachISO(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/iso/attach", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, "systemrescue-10", r.Form.Get("iso_id"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.AttachISO(context.Background(), id, "systemrescue-10")
+}
+
+func TestDetachISO(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/iso/detach", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.NotContains(t, r.Form, "iso_id")
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.DetachISO(context.Background(), id)
+}
@@ -0,0 +1,95 @@
+package vm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Summary is a compact view of VM for listings, RAM is in MB and Disks is total storage size in GB.
+type Summary struct {
+	UUID             uuid.UUID `json:"uuid"`
+	Name             string    `json:"name"`
+	Hostname         string    `json:"hostname"`
+	Status           string    `json:"status"`
+	VCPU             int       `json:"vcpu"`
+	RAM              int       `json:"ram"`
+	Disks            int       `json:"disks"`
+	OSName           string    `json:"os_name"`
+	OSVersion        string    `json:"os_version"`
+	PrivateIPv4      string    `json:"private_ipv4"`
+	BillingAccountID int       `json:"billing_account_id"`
+	CreatedAt        string    `json:"created_at"`
+}
+
+// Summary returns compact view of VM.
+func (vm VM) Summary() Summary {
+	disks := 0
+	for _, s := range vm.Storage {
+		disks += s.Size
+	}
+	return Summary{
+		UUID:             vm.UUID,
+		Name:             vm.Name,
+		Hostname:         vm.Hostname,
+		Status:           vm.Status,
+		VCPU:             vm.VCPU,
+		RAM:              vm.Memory,
+		Disks:            disks,
+		OSName:           vm.OSName,
+		OSVersion:        vm.OSVersion,
+		PrivateIPv4:      vm.PrivateIPv4,
+		BillingAccountID: vm.BillingAccountID,
+		CreatedAt:        vm.CreatedAt,
+	}
+}
+
+// ListVMsOptions filters VMs on the client side, zero values are ignored.
+// Metadata and Tags match VMs having all given key/values in their metadata or tags respectively.
+type ListVMsOptions struct {
+	Status           string
+	BillingAccountID int
+	NamePrefix       string
+	Metadata         map[string]string
+	Tags             map[string]string
+}
+
+// Match tells whether VM passes all the filters.
+func (o ListVMsOptions) Match(vm VM) bool {
+	if o.Status != "" && vm.Status != o.Status {
+		return false
+	}
+	if o.BillingAccountID != 0 && vm.BillingAccountID != o.BillingAccountID {
+		return false
+	}
+	if o.NamePrefix != "" && !strings.HasPrefix(vm.Name, o.NamePrefix) {
+		return false
+	}
+	for k, v := range o.Metadata {
+		if mv, ok := vm.Metadata[k]; !ok || mv != v {
+			return false
+		}
+	}
+	for k, v := range o.Tags {
+		if tv, ok := vm.Metadata[TagPrefix+k]; !ok || tv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// ListVMSummaries returns summaries of VMs matching opts, see `ListVMs()`.
+func (c *Client) ListVMSummaries(ctx context.Context, opts ListVMsOptions) (*[]Summary, error) {
+	vms, err := c.ListVMs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summaries := []Summary{}
+	for _, vm := range *vms {
+		if opts.Match(vm) {
+			summaries = append(summaries, vm.Summary())
+		}
+	}
+	return &summaries, nil
+}
@@ -0,0 +1,45 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListVMsOptions_Match(t *testing.T) {
+	vm := VM{Name: "web-1", Status: StatusRunning, BillingAccountID: 1, Metadata: map[string]string{"role": "web"}}
+
+	assert.True(t, ListVMsOptions{}.Match(vm))
+	assert.True(t, ListVMsOptions{Status: StatusRunning, BillingAccountID: 1, NamePrefix: "web-", Metadata: map[string]string{"role": "web"}}.Match(vm))
+	assert.False(t, ListVMsOptions{Status: StatusStopped}.Match(vm))
+	assert.False(t, ListVMsOptions{BillingAccountID: 2}.Match(vm))
+	assert.False(t, ListVMsOptions{NamePrefix: "db-"}.Match(vm))
+	assert.False(t, ListVMsOptions{Metadata: map[string]string{"role": "db"}}.Match(vm))
+	assert.False(t, ListVMsOptions{Metadata: map[string]string{"team": ""}}.Match(vm))
+
+	vm.Metadata["tag:team"] = "payments"
+	assert.True(t, ListVMsOptions{Tags: map[string]string{"team": "payments"}}.Match(vm))
+	assert.False(t, ListVMsOptions{Tags: map[string]string{"role": "web"}}.Match(vm))
+}
+
+func TestListVMSummaries(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/list", loc), r.RequestURI)
+		fmt.Fprintf(w, `[
+			{"uuid":"%s","name":"web-1","status":"running","memory":2048,"storage":[{"size":20},{"size":30}]},
+			{"name":"web-2","status":"stopped"},
+			{"name":"db-1","status":"running"}
+		]`, id)
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	summaries, err := vm.ListVMSummaries(context.Background(), ListVMsOptions{Status: StatusRunning, NamePrefix: "web-"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Summary{{UUID: id, Name: "web-1", Status: StatusRunning, RAM: 2048, Disks: 50}}, *summaries)
+}
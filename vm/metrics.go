@@ -0,0 +1,79 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/query"
+	"github.com/google/uuid"
+)
+
+// VM metrics, unit of each series is reported in MetricSeries.Unit
+const (
+	MetricCPU        = "cpu"
+	MetricRAM        = "ram"
+	MetricDiskRead   = "disk_read"
+	MetricDiskWrite  = "disk_write"
+	MetricNetworkIn  = "network_in"
+	MetricNetworkOut = "network_out"
+)
+
+// MetricPoint is a single sample of a time series
+type MetricPoint struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// MetricSeries is a time series of a single metric, e.g. CPU usage in percent.
+type MetricSeries struct {
+	Metric string        `json:"metric"`
+	Unit   string        `json:"unit"`
+	Points []MetricPoint `json:"points"`
+}
+
+// MetricsOptions selects metrics and time range of `Client.GetVMMetrics()`.
+// Empty Metrics means all of them, zero Step lets the API pick resolution based on the time range.
+type MetricsOptions struct {
+	Metrics []string      `qs:"metrics,omitempty,comma"`
+	From    time.Time     `qs:"start_time"`
+	To      time.Time     `qs:"end_time"`
+	Step    time.Duration `qs:"step,omitempty"`
+}
+
+// Validate checks the time range, it's called by `Client.GetVMMetrics()` before sending the request.
+func (o MetricsOptions) Validate() error {
+	if o.From.IsZero() || o.To.IsZero() || !o.To.After(o.From) {
+		return fmt.Errorf("To with value of %v is invalid, must be after From", o.To.Format(time.RFC3339))
+	}
+	for _, m := range o.Metrics {
+		switch m {
+		case MetricCPU, MetricRAM, MetricDiskRead, MetricDiskWrite, MetricNetworkIn, MetricNetworkOut:
+		default:
+			return fmt.Errorf("Metrics with value of %v is invalid, %s is unknown", o.Metrics, m)
+		}
+	}
+	if o.Step < 0 {
+		return fmt.Errorf("Step with value of %v is invalid, must not be negative", o.Step)
+	}
+	return nil
+}
+
+// GetVMMetrics https://api.warren.io/#vm-metrics
+func (c *Client) GetVMMetrics(ctx context.Context, id uuid.UUID, opts MetricsOptions) (*[]MetricSeries, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	q, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+	q.Set("uuid", id.String())
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/metrics", c.Location),
+		Query:  q,
+	}
+	return api.Call[[]MetricSeries](ctx, c.API, rc)
+}
@@ -0,0 +1,51 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetVMMetrics(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/metrics", loc), r.URL.Path)
+
+		q := r.URL.Query()
+		assert.Equal(t, id.String(), q.Get("uuid"))
+		assert.Equal(t, "2024-01-01T00:00:00Z", q.Get("start_time"))
+		assert.Equal(t, "2024-01-01T01:00:00Z", q.Get("end_time"))
+		assert.Equal(t, "cpu,network_in", q.Get("metrics"))
+		assert.Equal(t, "300", q.Get("step"))
+		w.Write([]byte(`[{"metric":"cpu","unit":"percent","points":[{"time":"2024-01-01T00:00:00Z","value":12.5}]}]`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	series, err := vm.GetVMMetrics(context.Background(), id, MetricsOptions{
+		Metrics: []string{MetricCPU, MetricNetworkIn},
+		From:    from,
+		To:      from.Add(time.Hour),
+		Step:    5 * time.Minute,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, *series, 1)
+	cpu := (*series)[0]
+	assert.Equal(t, MetricCPU, cpu.Metric)
+	assert.Equal(t, from, cpu.Points[0].Time)
+	assert.Equal(t, 12.5, cpu.Points[0].Value)
+}
+
+func TestMetricsOptions_Validate(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, MetricsOptions{From: from, To: from.Add(time.Hour)}.Validate())
+	assert.EqualError(t, MetricsOptions{From: from, To: from}.Validate(), "To with value of 2024-01-01T00:00:00Z is invalid, must be after From")
+	assert.EqualError(t, MetricsOptions{From: from, To: from.Add(time.Hour), Metrics: []string{"gpu"}}.Validate(), "Metrics with value of [gpu] is invalid, gpu is unknown")
+	assert.EqualError(t, MetricsOptions{From: from, To: from.Add(time.Hour), Step: -time.Second}.Validate(), "Step with value of -1s is invalid, must not be negative")
+}
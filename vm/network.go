@@ -0,0 +1,63 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+// ListVMNetworkInterfaces https://api.warren.io/#list-vm-network-interfaces
+func (c *Client) ListVMNetworkInterfaces(ctx context.Context, id uuid.UUID) (*[]NetworkInterface, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/network", c.Location),
+		Query:  url.Values{"uuid": []string{id.String()}},
+	}
+	var nics []NetworkInterface
+	if err := c.API.FormRequest(ctx, rc).Into(&nics); err != nil {
+		return nil, err
+	}
+	return &nics, nil
+}
+
+// AttachVMToNetwork https://api.warren.io/#attach-vm-to-network
+// Empty privateIPv4 lets the API pick a free address, otherwise reserve it first with `vpc.Client.ReservePrivateIP()`.
+func (c *Client) AttachVMToNetwork(ctx context.Context, id, networkID uuid.UUID, privateIPv4 string) (*NetworkInterface, error) {
+	d := url.Values{
+		"uuid":         []string{id.String()},
+		"network_uuid": []string{networkID.String()},
+	}
+	if privateIPv4 != "" {
+		if ip := net.ParseIP(privateIPv4); ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("PrivateIPv4 with value of %v is invalid", privateIPv4)
+		}
+		d.Set("private_ipv4", privateIPv4)
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/network", c.Location),
+		Data:   d,
+	}
+	var nic NetworkInterface
+	if err := c.API.FormRequest(ctx, rc).Into(&nic); err != nil {
+		return nil, err
+	}
+	return &nic, nil
+}
+
+// DetachVMFromNetwork https://api.warren.io/#detach-vm-from-network
+func (c *Client) DetachVMFromNetwork(ctx context.Context, id, networkID uuid.UUID) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/network", c.Location),
+		Data: url.Values{
+			"uuid":         []string{id.String()},
+			"network_uuid": []string{networkID.String()},
+		},
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
@@ -0,0 +1,89 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var networkID uuid.UUID = uuid.MustParse("5d3c1a7e-2b4f-4c6d-9e8f-1a2b3c4d5e6f")
+
+func TestListVMNetworkInterfaces(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/network?uuid=%s", loc, id), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`[{"mac":"52:54:00:12:34:56","private_ipv4":"10.0.0.10","network_uuid":"%s","primary":true}]`, networkID)))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	nics, err := vm.ListVMNetworkInterfaces(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Len(t, *nics, 1)
+	assert.Equal(t, "52:54:00:12:34:56", (*nics)[0].MAC)
+	assert.Equal(t, "10.0.0.10", (*nics)[0].PrivateIPv4)
+	assert.Equal(t, networkID, (*nics)[0].NetworkUUID)
+	assert.True(t, (*nics)[0].Primary)
+}
+
+func TestAttachVMToNetwork(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/network", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, networkID.String(), r.Form.Get("network_uuid"))
+		assert.Equal(t, "10.0.0.11", r.Form.Get("private_ipv4"))
+		w.Write([]byte(fmt.Sprintf(`{"private_ipv4":"10.0.0.11","network_uuid":"%s"}`, networkID)))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+
+	// invalid address is not sent
+	_, err := vm.AttachVMToNetwork(context.Background(), id, networkID, "10.0.0")
+	assert.EqualError(t, err, "PrivateIPv4 with value of 10.0.0 is invalid")
+
+	nic, err := vm.AttachVMToNetwork(context.Background(), id, networkID, "10.0.0.11")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.11", nic.PrivateIPv4)
+	assert.Equal(t, networkID, nic.NetworkUUID)
+}
+
+func TestAttachVMToNetwork_AnyAddress(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		assert.NotContains(t, r.Form, "private_ipv4")
+		w.Write([]byte(`{}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	_, err := vm.AttachVMToNetwork(context.Background(), id, networkID, "")
+	assert.NoError(t, err)
+}
+
+func TestDetachVMFromNetwork(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/network", loc), r.RequestURI)
+
+		// ParseForm() ignores DELETE body
+		b, _ := io.ReadAll(r.Body)
+		d, _ := url.ParseQuery(string(b))
+		assert.Equal(t, id.String(), d.Get("uuid"))
+		assert.Equal(t, networkID.String(), d.Get("network_uuid"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	assert.NoError(t, vm.DetachVMFromNetwork(context.Background(), id, networkID))
+}
@@ -0,0 +1,50 @@
+package vm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// TagPrefix marks metadata entries that are tags, tag `team=payments` is stored as `tag:team=payments`.
+// Tags are a client-side convention on top of VM metadata, other metadata entries are left untouched.
+const TagPrefix = "tag:"
+
+// Tags returns tags of VM stored in its metadata.
+func (vm VM) Tags() map[string]string {
+	tags := map[string]string{}
+	for k, v := range vm.Metadata {
+		if strings.HasPrefix(k, TagPrefix) {
+			tags[strings.TrimPrefix(k, TagPrefix)] = v
+		}
+	}
+	return tags
+}
+
+// GetTags returns tags of VM, see `VM.Tags()`.
+func (c *Client) GetTags(ctx context.Context, id uuid.UUID) (map[string]string, error) {
+	vm, err := c.GetVM(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return vm.Tags(), nil
+}
+
+// SetTags replaces all tags of VM while keeping the rest of its metadata, see `UpdateVMMetadata()`.
+func (c *Client) SetTags(ctx context.Context, id uuid.UUID, tags map[string]string) (*VM, error) {
+	vm, err := c.GetVM(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]string{}
+	for k, v := range vm.Metadata {
+		if !strings.HasPrefix(k, TagPrefix) {
+			metadata[k] = v
+		}
+	}
+	for k, v := range tags {
+		metadata[TagPrefix+k] = v
+	}
+	return c.UpdateVMMetadata(ctx, id, metadata)
+}
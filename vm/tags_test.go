@@ -0,0 +1,53 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVM_Tags(t *testing.T) {
+	vm := VM{Metadata: map[string]string{"role": "web", "tag:team": "payments", "tag:env": "prod"}}
+	assert.Equal(t, map[string]string{"team": "payments", "env": "prod"}, vm.Tags())
+	assert.Empty(t, VM{}.Tags())
+}
+
+func TestGetTags(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm?uuid=%s", loc, id), r.RequestURI)
+		w.Write([]byte(`{"metadata":{"role":"web","tag:team":"payments"}}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	tags, err := vm.GetTags(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "payments"}, tags)
+}
+
+func TestSetTags(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			w.Write([]byte(`{"metadata":{"role":"web","tag:team":"payments"}}`))
+			return
+		}
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/metadata", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		var metadata map[string]string
+		_ = json.Unmarshal([]byte(r.Form.Get("metadata")), &metadata)
+		assert.Equal(t, map[string]string{"role": "web", "tag:env": "prod"}, metadata)
+		w.Write([]byte(`{}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	_, err := vm.SetTags(context.Background(), id, map[string]string{"env": "prod"})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,285 @@
+package vm
+
+import (
+	"fmt"
+	"net"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/image"
+	"github.com/google/uuid"
+)
+
+type Client struct {
+	API      *api.API
+	Location string
+}
+
+// VM statuses as reported by the API
+const (
+	StatusCreating  = "creating"
+	StatusRunning   = "running"
+	StatusStopped   = "stopped"
+	StatusStarting  = "starting"
+	StatusStopping  = "stopping"
+	StatusRestoring = "restoring"
+	StatusDeleted   = "deleted"
+)
+
+// Allowed VM resources, RAM is in MB
+const (
+	MinVCPU = 1
+	MaxVCPU = 16
+	MinRAM  = 512
+	MaxRAM  = 65536
+)
+
+// MinPasswordLength is minimum length of VM user password
+const MinPasswordLength = 8
+
+// Storage is a disk attached to VM
+type Storage struct {
+	ID         int       `json:"id"`
+	UUID       uuid.UUID `json:"uuid"`
+	Name       string    `json:"name"`
+	Pool       string    `json:"pool"`
+	Primary    bool      `json:"primary"`
+	Shared     bool      `json:"shared"`
+	Size       int       `json:"size"`
+	Type       string    `json:"type"`
+	UserID     int       `json:"user_id"`
+	DevicePath string    `json:"device_path"`
+	CreatedAt  string    `json:"created_at"`
+	UpdatedAt  string    `json:"updated_at"`
+}
+
+// Attachment is a disk attached to VM, DevicePath (e.g. /dev/vdb) is empty when not reported by the API.
+type Attachment struct {
+	VMUUID     uuid.UUID
+	DiskUUID   uuid.UUID
+	DevicePath string
+	Primary    bool
+}
+
+// VM represents virtual machine
+type VM struct {
+	ID                 int               `json:"id"`
+	UUID               uuid.UUID         `json:"uuid"`
+	Name               string            `json:"name"`
+	Hostname           string            `json:"hostname"`
+	Description        string            `json:"description"`
+	Status             string            `json:"status"`
+	Username           string            `json:"username"`
+	VCPU               int               `json:"vcpu"`
+	Memory             int               `json:"memory"`
+	OSName             string            `json:"os_name"`
+	OSVersion          string            `json:"os_version"`
+	MAC                string            `json:"mac"`
+	PrivateIPv4        string            `json:"private_ipv4"`
+	Storage            []Storage         `json:"storage"`
+	Backup             bool              `json:"backup"`
+	BillingAccountID   int               `json:"billing_account"`
+	UserID             int               `json:"user_id"`
+	DesignatedPoolUUID uuid.NullUUID     `json:"designated_pool_uuid"`
+	Metadata           map[string]string `json:"metadata"`
+	CreatedAt          string            `json:"created_at"`
+	UpdatedAt          string            `json:"updated_at"`
+}
+
+// NetworkInterface is VM's NIC connected to a private network (see `vpc` module), Primary is the one VM was created with.
+type NetworkInterface struct {
+	MAC         string    `json:"mac"`
+	PrivateIPv4 string    `json:"private_ipv4"`
+	NetworkUUID uuid.UUID `json:"network_uuid"`
+	Primary     bool      `json:"primary"`
+}
+
+// Backup is a scheduled backup of VM
+type Backup struct {
+	UUID      uuid.UUID `json:"uuid"`
+	VMUUID    uuid.UUID `json:"vm_uuid"`
+	SizeGB    int       `json:"size_gb"`
+	Status    string    `json:"status"`
+	CreatedAt string    `json:"created_at"`
+}
+
+// ISO is an installer or rescue image that can be attached to VM
+type ISO struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SizeMB      int    `json:"size_mb"`
+}
+
+// Console holds VNC console access to VM, URL is short-lived and embeds Token.
+type Console struct {
+	URL   string `json:"url"`
+	Token string `json:"token"`
+}
+
+// CreateVMConfig holds parameters to create a new VM, RAM is in MB and Disks (primary disk size) in GB.
+// SSH key can be given either by name of a key stored with `sshkey` module (SSHKeyName) or as key material (PublicKey).
+// UserData is cloud-init config run on first boot, Metadata is arbitrary key/values attached to VM.
+// PrivateIPv4 assigns fixed address within NetworkUUID, reserve it first with `vpc.Client.ReservePrivateIP()`.
+// Public IP is assigned by default, set NoPublicIP for VM reachable from private network only.
+// Backup enables automatic backups, see `Client.ListVMBackups()`.
+// SourceImage is ID of custom image (see `image.Client.CreateImageFromDisk()`) to create VM from, OSName and OSVersion
+// are optional then.
+type CreateVMConfig struct {
+	Name             string            `schema:"name"`
+	Description      string            `schema:"description,omitempty"`
+	OSName           string            `schema:"os_name,omitempty"`
+	OSVersion        string            `schema:"os_version,omitempty"`
+	SourceImage      string            `schema:"source_replica,omitempty"`
+	VCPU             int               `schema:"vcpu"`
+	RAM              int               `schema:"ram"`
+	Disks            int               `schema:"disks"`
+	Username         string            `schema:"username"`
+	Password         string            `schema:"password,omitempty"`
+	SSHKeyName       string            `schema:"ssh_key_name,omitempty"`
+	PublicKey        string            `schema:"public_key,omitempty"`
+	NetworkUUID      string            `schema:"network_uuid,omitempty"`
+	PrivateIPv4      string            `schema:"private_ipv4,omitempty"`
+	NoPublicIP       bool              `schema:"-"`
+	UserData         string            `schema:"cloud_init,omitempty"`
+	Metadata         map[string]string `schema:"-"`
+	Backup           bool              `schema:"backup,omitempty"`
+	BillingAccountID int               `schema:"billing_account_id"`
+}
+
+// Validate checks fields that are required by the API, it's called by `Client.CreateVM()` before sending the request.
+// Password is required unless SSHKeyName or PublicKey is set.
+func (cfg CreateVMConfig) Validate() error {
+	if cfg.Name == "" {
+		return fmt.Errorf("Name with value of %q is invalid", cfg.Name)
+	}
+	if cfg.SourceImage == "" && (cfg.OSName == "" || cfg.OSVersion == "") {
+		return fmt.Errorf("OSName and OSVersion with value of %q %q is invalid", cfg.OSName, cfg.OSVersion)
+	}
+	if cfg.VCPU < MinVCPU || cfg.VCPU > MaxVCPU {
+		return fmt.Errorf("VCPU with value of %v is invalid, must be between %d and %d", cfg.VCPU, MinVCPU, MaxVCPU)
+	}
+	if cfg.RAM < MinRAM || cfg.RAM > MaxRAM {
+		return fmt.Errorf("RAM with value of %v is invalid, must be between %d and %d", cfg.RAM, MinRAM, MaxRAM)
+	}
+	if cfg.Disks <= 0 {
+		return fmt.Errorf("Disks with value of %v is invalid, must be larger than 0", cfg.Disks)
+	}
+	if cfg.Username == "" {
+		return fmt.Errorf("Username with value of %q is invalid", cfg.Username)
+	}
+	if cfg.Password != "" {
+		if err := ValidatePassword(cfg.Password); err != nil {
+			return err
+		}
+	} else if cfg.SSHKeyName == "" && cfg.PublicKey == "" {
+		return fmt.Errorf("Password is invalid, must be set when SSHKeyName and PublicKey are empty")
+	}
+	if cfg.PrivateIPv4 != "" {
+		if ip := net.ParseIP(cfg.PrivateIPv4); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("PrivateIPv4 with value of %v is invalid", cfg.PrivateIPv4)
+		}
+		if cfg.NetworkUUID == "" {
+			return fmt.Errorf("NetworkUUID with value of %q is invalid, must be set with PrivateIPv4", cfg.NetworkUUID)
+		}
+	}
+	if cfg.BillingAccountID < 0 {
+		return fmt.Errorf("BillingAccountID with value of %v is invalid", cfg.BillingAccountID)
+	}
+	return nil
+}
+
+// ValidateImage checks OSName and OSVersion (or SourceImage when set) against images catalog
+// (see `image.Client.ListImages()`) and that Disks is large enough for it.
+func (cfg CreateVMConfig) ValidateImage(images []image.Image) error {
+	if cfg.SourceImage != "" {
+		img := image.Find(images, cfg.SourceImage)
+		if img == nil || img.Type != image.TypeDisk {
+			return fmt.Errorf("SourceImage with value of %v is invalid", cfg.SourceImage)
+		}
+		if cfg.Disks < img.MinDiskSizeGB {
+			return fmt.Errorf("Disks with value of %v is invalid, must be at least %d", cfg.Disks, img.MinDiskSizeGB)
+		}
+		return nil
+	}
+	img := image.FindOS(images, cfg.OSName, cfg.OSVersion)
+	if img == nil {
+		return fmt.Errorf("OSName and OSVersion with value of %v %v is invalid", cfg.OSName, cfg.OSVersion)
+	}
+	if cfg.Disks < img.MinDiskSizeGB {
+		return fmt.Errorf("Disks with value of %v is invalid, must be at least %d", cfg.Disks, img.MinDiskSizeGB)
+	}
+	return nil
+}
+
+// ValidatePassword checks that password is at least MinPasswordLength characters (not bytes) long
+// and contains uppercase letter, lowercase letter and digit, as required by the API.
+func ValidatePassword(password string) error {
+	var upper, lower, digit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		}
+	}
+	if utf8.RuneCountInString(password) < MinPasswordLength || !upper || !lower || !digit {
+		return fmt.Errorf("Password is invalid, must be at least %d characters and contain uppercase letter, lowercase letter and digit", MinPasswordLength)
+	}
+	return nil
+}
+
+// DeleteVMOptions controls `Client.DeleteVMWithOptions()`, zero value deletes the VM only like `Client.DeleteVM()`.
+// DeleteFloatingIPs and DeleteDisks delete floating IPs assigned to VM and its non-primary disks after VM is deleted.
+// RequireStopped refuses to delete VM that's not stopped, Force deletes it even when it's running or busy,
+// only one of them can be set.
+type DeleteVMOptions struct {
+	DeleteFloatingIPs bool
+	DeleteDisks       bool
+	Force             bool
+	RequireStopped    bool
+}
+
+// Validate checks that options don't contradict each other.
+func (opts DeleteVMOptions) Validate() error {
+	if opts.Force && opts.RequireStopped {
+		return fmt.Errorf("Force and RequireStopped with value of %v %v is invalid, only one can be set", opts.Force, opts.RequireStopped)
+	}
+	return nil
+}
+
+// PreconditionError is returned when VM is not in a state the operation requires, nothing is changed.
+type PreconditionError struct {
+	VMUUID   uuid.UUID
+	Status   string
+	Required string
+}
+
+func (e *PreconditionError) Error() string {
+	return fmt.Sprintf("vm %s is %s, must be %s", e.VMUUID, e.Status, e.Required)
+}
+
+// ModifyVMConfig holds VM attributes to change, zero values are left unchanged. RAM is in MB.
+type ModifyVMConfig struct {
+	Name string `schema:"name,omitempty"`
+	VCPU int    `schema:"vcpu,omitempty"`
+	RAM  int    `schema:"ram,omitempty"`
+}
+
+// Validate checks that there's something to modify and that VCPU and RAM are within allowed bounds.
+func (cfg ModifyVMConfig) Validate() error {
+	if cfg == (ModifyVMConfig{}) {
+		return fmt.Errorf("ModifyVMConfig with value of %+v is invalid, at least one field must be set", cfg)
+	}
+	if cfg.VCPU != 0 && (cfg.VCPU < MinVCPU || cfg.VCPU > MaxVCPU) {
+		return fmt.Errorf("VCPU with value of %v is invalid, must be between %d and %d", cfg.VCPU, MinVCPU, MaxVCPU)
+	}
+	if cfg.RAM != 0 && (cfg.RAM < MinRAM || cfg.RAM > MaxRAM) {
+		return fmt.Errorf("RAM with value of %v is invalid, must be between %d and %d", cfg.RAM, MinRAM, MaxRAM)
+	}
+	return nil
+}
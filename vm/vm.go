@@ -1 +1,339 @@
 package vm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/bulk"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+)
+
+func NewClient(client *api.API, location string) *Client {
+	return &Client{
+		API:      client,
+		Location: location,
+	}
+}
+
+// ListVMs https://api.warren.io/#list-vms
+func (c *Client) ListVMs(ctx context.Context) (*[]VM, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/list", c.Location),
+	}
+	var vms []VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vms); err != nil {
+		return nil, err
+	}
+	return &vms, nil
+}
+
+// ListVMsIterator returns iterator over `ListVMs()` results, fetching limit items per page.
+func (c *Client) ListVMsIterator(limit int) *api.Iterator[VM] {
+	return api.NewIterator(func(ctx context.Context, page, limit int) ([]VM, error) {
+		rc := api.RequestConfig{
+			Method: "GET",
+			Path:   fmt.Sprintf("/v1/%s/user-resource/vm/list", c.Location),
+			Page:   page,
+			Limit:  limit,
+		}
+		var vms []VM
+		if err := c.API.FormRequest(ctx, rc).Into(&vms); err != nil {
+			return nil, err
+		}
+		return vms, nil
+	}, limit)
+}
+
+// CreateVM https://api.warren.io/#create-vm
+func (c *Client) CreateVM(ctx context.Context, cfg CreateVMConfig) (*VM, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	d, err := api.EncodeForm(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.NoPublicIP {
+		d.Set("reserve_public_ip", "false")
+	}
+	if len(cfg.Metadata) > 0 {
+		m, err := json.Marshal(cfg.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		d.Set("metadata", string(m))
+	}
+
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm", c.Location),
+		Data:   d,
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// GetVM https://api.warren.io/#get-vm
+func (c *Client) GetVM(ctx context.Context, id uuid.UUID) (*VM, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm", c.Location),
+		Query:  url.Values{"uuid": []string{id.String()}},
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// DeleteVM https://api.warren.io/#delete-vm
+func (c *Client) DeleteVM(ctx context.Context, id uuid.UUID) error {
+	return c.deleteVM(ctx, id, false)
+}
+
+// deleteVM sends delete request, force makes the API delete VM that's running or busy.
+func (c *Client) deleteVM(ctx context.Context, id uuid.UUID, force bool) error {
+	d := url.Values{"uuid": []string{id.String()}}
+	if force {
+		d.Set("force", "true")
+	}
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm", c.Location),
+		Data:   d,
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
+
+// DeleteVMs deletes VMs concurrently, failed ones are reported in *bulk.Error[uuid.UUID].
+func (c *Client) DeleteVMs(ctx context.Context, ids []uuid.UUID, opts bulk.Options) error {
+	return bulk.Run(ctx, ids, opts, c.DeleteVM)
+}
+
+// StartVM https://api.warren.io/#start-vm
+func (c *Client) StartVM(ctx context.Context, id uuid.UUID) (*VM, error) {
+	return c.powerAction(ctx, id, "start")
+}
+
+// StopVM https://api.warren.io/#stop-vm
+// It's a graceful (ACPI) shutdown which the guest OS may delay or ignore, see `PowerOffVM()` and `ShutdownVM()`.
+func (c *Client) StopVM(ctx context.Context, id uuid.UUID) (*VM, error) {
+	return c.powerAction(ctx, id, "stop")
+}
+
+// PowerOffVM https://api.warren.io/#power-off-vm
+// It cuts the power without notifying the guest OS, unsaved data may be lost.
+func (c *Client) PowerOffVM(ctx context.Context, id uuid.UUID) (*VM, error) {
+	return c.powerAction(ctx, id, "poweroff")
+}
+
+// RebootVM https://api.warren.io/#reboot-vm
+func (c *Client) RebootVM(ctx context.Context, id uuid.UUID) (*VM, error) {
+	return c.powerAction(ctx, id, "reboot")
+}
+
+// powerAction calls VM power management endpoint and returns VM with its resulting status.
+func (c *Client) powerAction(ctx context.Context, id uuid.UUID, action string) (*VM, error) {
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/%s", c.Location, action),
+		Data:   url.Values{"uuid": []string{id.String()}},
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// ModifyVM https://api.warren.io/#modify-vm
+func (c *Client) ModifyVM(ctx context.Context, id uuid.UUID, cfg ModifyVMConfig) (*VM, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	d, err := api.EncodeForm(cfg)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("uuid", id.String())
+
+	rc := api.RequestConfig{
+		Method: "PATCH",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm", c.Location),
+		Data:   d,
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// RenameVM https://api.warren.io/#modify-vm
+func (c *Client) RenameVM(ctx context.Context, id uuid.UUID, name string) (*VM, error) {
+	if name == "" {
+		return nil, fmt.Errorf("Name with value of %q is invalid", name)
+	}
+	return c.ModifyVM(ctx, id, ModifyVMConfig{Name: name})
+}
+
+// UpdateVMDescription https://api.warren.io/#modify-vm
+// Empty description clears the existing one.
+func (c *Client) UpdateVMDescription(ctx context.Context, id uuid.UUID, description string) (*VM, error) {
+	rc := api.RequestConfig{
+		Method: "PATCH",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm", c.Location),
+		Data: url.Values{
+			"uuid":        []string{id.String()},
+			"description": []string{description},
+		},
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// UpdateVMMetadata https://api.warren.io/#update-vm-metadata
+// Metadata replaces all existing key/values of VM.
+func (c *Client) UpdateVMMetadata(ctx context.Context, id uuid.UUID, metadata map[string]string) (*VM, error) {
+	m, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	rc := api.RequestConfig{
+		Method: "PUT",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/metadata", c.Location),
+		Data: url.Values{
+			"uuid":     []string{id.String()},
+			"metadata": []string{string(m)},
+		},
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// ResetVMPassword https://api.warren.io/#reset-vm-password
+func (c *Client) ResetVMPassword(ctx context.Context, id uuid.UUID, newPassword string) error {
+	if err := ValidatePassword(newPassword); err != nil {
+		return err
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/password", c.Location),
+		Data: url.Values{
+			"uuid":     []string{id.String()},
+			"password": []string{newPassword},
+		},
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
+
+// CloneVM https://api.warren.io/#clone-vm
+// It returns the newly created VM, poll its status with `GetVM()` until it's ready.
+func (c *Client) CloneVM(ctx context.Context, id uuid.UUID, newName string) (*VM, error) {
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/clone", c.Location),
+		Data: url.Values{
+			"uuid": []string{id.String()},
+			"name": []string{newName},
+		},
+	}
+	var vm VM
+	if err := c.API.FormRequest(ctx, rc).Into(&vm); err != nil {
+		return nil, err
+	}
+	return &vm, nil
+}
+
+// GetVMConsole https://api.warren.io/#vm-console
+func (c *Client) GetVMConsole(ctx context.Context, id uuid.UUID) (*Console, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/user-resource/vm/console", c.Location),
+		Query:  url.Values{"uuid": []string{id.String()}},
+	}
+	var console Console
+	if err := c.API.FormRequest(ctx, rc).Into(&console); err != nil {
+		return nil, err
+	}
+	return &console, nil
+}
+
+// GetVMConsoleURL returns URL of VM VNC console, see `GetVMConsole()`.
+func (c *Client) GetVMConsoleURL(ctx context.Context, id uuid.UUID) (string, error) {
+	console, err := c.GetVMConsole(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return console.URL, nil
+}
+
+// WaitForVMStatus polls VM until it reaches given status or ctx is done, and returns the latest VM.
+func (c *Client) WaitForVMStatus(ctx context.Context, id uuid.UUID, status string, opts ...waiter.Option) (*VM, error) {
+	var vm *VM
+	err := waiter.ForStatus(ctx, func(ctx context.Context) (string, error) {
+		v, err := c.GetVM(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		vm = v
+		return v.Status, nil
+	}, status, opts...)
+	return vm, err
+}
+
+// WaitForVMDeleted polls VM until it no longer exists (or is reported deleted) or ctx is done.
+func (c *Client) WaitForVMDeleted(ctx context.Context, id uuid.UUID, opts ...waiter.Option) error {
+	return waiter.Until(ctx, func(ctx context.Context) (bool, error) {
+		v, err := c.GetVM(ctx, id)
+		if errors.Is(err, api.ErrNotFound) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return v.Status == StatusDeleted, nil
+	}, opts...)
+}
+
+// ShutdownVM stops VM gracefully and powers it off when it's not stopped within timeout,
+// then waits until it's stopped or ctx is done. The returned VM is the latest one seen.
+func (c *Client) ShutdownVM(ctx context.Context, id uuid.UUID, timeout time.Duration, opts ...waiter.Option) (*VM, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, err := c.StopVM(ctx, id); err != nil {
+		return nil, err
+	}
+	graceful, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	vm, err := c.WaitForVMStatus(graceful, id, StatusStopped, opts...)
+	// give up on graceful shutdown only when it's our timeout that expired
+	if err == nil || graceful.Err() == nil || ctx.Err() != nil {
+		return vm, err
+	}
+
+	if _, err := c.PowerOffVM(ctx, id); err != nil {
+		return vm, err
+	}
+	return c.WaitForVMStatus(ctx, id, StatusStopped, opts...)
+}
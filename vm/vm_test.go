@@ -0,0 +1,682 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/bulk"
+	"github.com/ekaputra07/warren-go/image"
+	"github.com/ekaputra07/warren-go/waiter"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	loc string    = "jkt01"
+	id  uuid.UUID = uuid.MustParse("4e5eadd3-8b11-4c34-812a-2cf97120b628")
+)
+
+func TestListVMs(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/list", loc), r.RequestURI)
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.ListVMs(context.Background())
+}
+
+func TestCreateVM(t *testing.T) {
+	cfg := CreateVMConfig{
+		Name:             "test",
+		OSName:           "ubuntu",
+		OSVersion:        "20.04",
+		VCPU:             2,
+		RAM:              2048,
+		Disks:            20,
+		Username:         "admin",
+		Password:         "Secret123",
+		BillingAccountID: 123,
+	}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, "test", r.Form.Get("name"))
+		assert.Equal(t, "ubuntu", r.Form.Get("os_name"))
+		assert.Equal(t, "20.04", r.Form.Get("os_version"))
+		assert.Equal(t, "2", r.Form.Get("vcpu"))
+		assert.Equal(t, "2048", r.Form.Get("ram"))
+		assert.Equal(t, "20", r.Form.Get("disks"))
+		assert.Equal(t, "admin", r.Form.Get("username"))
+		assert.Equal(t, "Secret123", r.Form.Get("password"))
+		assert.Equal(t, "123", r.Form.Get("billing_account_id"))
+		assert.NotContains(t, r.Form, "description")
+		assert.NotContains(t, r.Form, "backup")
+		assert.NotContains(t, r.Form, "reserve_public_ip")
+
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","name":"test","status":"creating"}`, id)))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	created, err := vm.CreateVM(context.Background(), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, id, created.UUID)
+	assert.Equal(t, "creating", created.Status)
+}
+
+func TestCreateVM_SSHKey(t *testing.T) {
+	cfg := CreateVMConfig{
+		Name:             "test",
+		OSName:           "ubuntu",
+		OSVersion:        "20.04",
+		VCPU:             2,
+		RAM:              2048,
+		Disks:            20,
+		Username:         "admin",
+		SSHKeyName:       "laptop",
+		BillingAccountID: 123,
+	}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		assert.Equal(t, "laptop", r.Form.Get("ssh_key_name"))
+		assert.NotContains(t, r.Form, "public_key")
+		assert.NotContains(t, r.Form, "password")
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.CreateVM(context.Background(), cfg)
+}
+
+func TestCreateVM_NetworkAndBackup(t *testing.T) {
+	cfg := CreateVMConfig{
+		Name:             "test",
+		OSName:           "ubuntu",
+		OSVersion:        "20.04",
+		VCPU:             2,
+		RAM:              2048,
+		Disks:            20,
+		Username:         "admin",
+		PublicKey:        "ssh-ed25519 AAAA",
+		NetworkUUID:      id.String(),
+		PrivateIPv4:      "10.0.0.10",
+		NoPublicIP:       true,
+		Backup:           true,
+		BillingAccountID: 123,
+	}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("network_uuid"))
+		assert.Equal(t, "10.0.0.10", r.Form.Get("private_ipv4"))
+		assert.Equal(t, "false", r.Form.Get("reserve_public_ip"))
+		assert.Equal(t, "true", r.Form.Get("backup"))
+		assert.Equal(t, "ssh-ed25519 AAAA", r.Form.Get("public_key"))
+		assert.NotContains(t, r.Form, "NoPublicIP")
+		w.Write([]byte(`{}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	_, err := vm.CreateVM(context.Background(), cfg)
+	assert.NoError(t, err)
+}
+
+func TestCreateVM_UserData(t *testing.T) {
+	cfg := CreateVMConfig{
+		Name:       "test",
+		OSName:     "ubuntu",
+		OSVersion:  "20.04",
+		VCPU:       2,
+		RAM:        2048,
+		Disks:      20,
+		Username:   "admin",
+		SSHKeyName: "laptop",
+		UserData:   "#cloud-config\npackages: [nginx]",
+		Metadata:   map[string]string{"role": "web"},
+	}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		assert.Equal(t, "#cloud-config\npackages: [nginx]", r.Form.Get("cloud_init"))
+		assert.Equal(t, `{"role":"web"}`, r.Form.Get("metadata"))
+		assert.NotContains(t, r.Form, "Metadata")
+		w.Write([]byte(`{"metadata":{"role":"web"}}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	created, err := vm.CreateVM(context.Background(), cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "web", created.Metadata["role"])
+}
+
+func TestCreateVM_PrivateIP(t *testing.T) {
+	network := "8d2d4e3a-5c86-4a4e-9d5b-0b3c4a0f2c11"
+	cfg := CreateVMConfig{
+		Name:        "test",
+		OSName:      "ubuntu",
+		OSVersion:   "20.04",
+		VCPU:        2,
+		RAM:         2048,
+		Disks:       20,
+		Username:    "admin",
+		SSHKeyName:  "laptop",
+		NetworkUUID: network,
+		PrivateIPv4: "10.0.0.10",
+	}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		assert.Equal(t, network, r.Form.Get("network_uuid"))
+		assert.Equal(t, "10.0.0.10", r.Form.Get("private_ipv4"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.CreateVM(context.Background(), cfg)
+}
+
+func TestCreateVMConfig_Validate(t *testing.T) {
+	valid := CreateVMConfig{
+		Name:      "test",
+		OSName:    "ubuntu",
+		OSVersion: "20.04",
+		VCPU:      2,
+		RAM:       2048,
+		Disks:     20,
+		Username:  "admin",
+		Password:  "Secret123",
+	}
+	assert.NoError(t, valid.Validate())
+
+	cfg := valid
+	cfg.Name = ""
+	assert.EqualError(t, cfg.Validate(), `Name with value of "" is invalid`)
+
+	cfg = valid
+	cfg.VCPU = 0
+	assert.EqualError(t, cfg.Validate(), "VCPU with value of 0 is invalid, must be between 1 and 16")
+
+	cfg = valid
+	cfg.RAM = 128
+	assert.EqualError(t, cfg.Validate(), "RAM with value of 128 is invalid, must be between 512 and 65536")
+
+	cfg = valid
+	cfg.Disks = 0
+	assert.EqualError(t, cfg.Validate(), "Disks with value of 0 is invalid, must be larger than 0")
+
+	cfg = valid
+	cfg.Password = ""
+	assert.EqualError(t, cfg.Validate(), "Password is invalid, must be set when SSHKeyName and PublicKey are empty")
+	cfg.PublicKey = "ssh-ed25519 AAAA"
+	assert.NoError(t, cfg.Validate())
+
+	cfg = valid
+	cfg.PrivateIPv4 = "10.0.0.300"
+	assert.EqualError(t, cfg.Validate(), "PrivateIPv4 with value of 10.0.0.300 is invalid")
+	cfg.PrivateIPv4 = "10.0.0.10"
+	assert.EqualError(t, cfg.Validate(), `NetworkUUID with value of "" is invalid, must be set with PrivateIPv4`)
+}
+
+func TestCreateVM_Invalid(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request must not be sent")
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	_, err := vm.CreateVM(context.Background(), CreateVMConfig{Name: "test"})
+	assert.Error(t, err)
+}
+
+func TestCreateVMConfig_ValidateImage(t *testing.T) {
+	images := []image.Image{{ID: "ubuntu_20.04", Name: "ubuntu", Version: "20.04", Type: image.TypeOSBase, MinDiskSizeGB: 20}}
+
+	cfg := CreateVMConfig{OSName: "ubuntu", OSVersion: "20.04", Disks: 20}
+	assert.NoError(t, cfg.ValidateImage(images))
+
+	cfg.Disks = 10
+	assert.EqualError(t, cfg.ValidateImage(images), "Disks with value of 10 is invalid, must be at least 20")
+
+	cfg.OSVersion = "22.04"
+	assert.EqualError(t, cfg.ValidateImage(images), "OSName and OSVersion with value of ubuntu 22.04 is invalid")
+}
+
+func TestCreateVMConfig_SourceImage(t *testing.T) {
+	images := []image.Image{
+		{ID: "ubuntu_20.04", Name: "ubuntu", Version: "20.04", Type: image.TypeOSBase, MinDiskSizeGB: 20},
+		{ID: "5f0c", Name: "web-template", Type: image.TypeDisk, MinDiskSizeGB: 40},
+	}
+	cfg := CreateVMConfig{Name: "web", SourceImage: "5f0c", VCPU: 2, RAM: 2048, Disks: 40, Username: "admin", SSHKeyName: "laptop"}
+	// OS is taken from the image
+	assert.NoError(t, cfg.Validate())
+	assert.NoError(t, cfg.ValidateImage(images))
+
+	cfg.Disks = 20
+	assert.EqualError(t, cfg.ValidateImage(images), "Disks with value of 20 is invalid, must be at least 40")
+
+	cfg.SourceImage = "ubuntu_20.04"
+	assert.EqualError(t, cfg.ValidateImage(images), "SourceImage with value of ubuntu_20.04 is invalid")
+
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		assert.Equal(t, "ubuntu_20.04", r.Form.Get("source_replica"))
+		assert.NotContains(t, r.Form, "os_name")
+		assert.NotContains(t, r.Form, "os_version")
+		w.Write([]byte(`{}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	_, err := vm.CreateVM(context.Background(), cfg)
+	assert.NoError(t, err)
+}
+
+func TestGetVM(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm?uuid=%s", loc, id), r.RequestURI)
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.GetVM(context.Background(), id)
+}
+
+func TestDeleteVM(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm", loc), r.RequestURI)
+
+		// ParseForm() ignores DELETE body
+		b, _ := io.ReadAll(r.Body)
+		d, _ := url.ParseQuery(string(b))
+		assert.Equal(t, id.String(), d.Get("uuid"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.DeleteVM(context.Background(), id)
+}
+
+func TestDeleteVMs(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	deleted := make(chan string, len(ids))
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		b, _ := io.ReadAll(r.Body)
+		d, _ := url.ParseQuery(string(b))
+		deleted <- d.Get("uuid")
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	assert.NoError(t, vm.DeleteVMs(context.Background(), ids, bulk.Options{}))
+	close(deleted)
+	var got []string
+	for id := range deleted {
+		got = append(got, id)
+	}
+	assert.ElementsMatch(t, []string{ids[0].String(), ids[1].String(), ids[2].String()}, got)
+}
+
+func TestStartVM(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/start", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.StartVM(context.Background(), id)
+}
+
+func TestStopVM(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/stop", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.StopVM(context.Background(), id)
+}
+
+func TestPowerOffVM(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/poweroff", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.PowerOffVM(context.Background(), id)
+}
+
+func TestRebootVM(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/reboot", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	vm.RebootVM(context.Background(), id)
+}
+
+func TestModifyVMConfig_Validate(t *testing.T) {
+	assert.Error(t, ModifyVMConfig{}.Validate())
+	assert.NoError(t, ModifyVMConfig{Name: "test"}.Validate())
+	assert.NoError(t, ModifyVMConfig{VCPU: MaxVCPU, RAM: MinRAM}.Validate())
+	assert.Error(t, ModifyVMConfig{VCPU: MaxVCPU + 1}.Validate())
+	assert.Error(t, ModifyVMConfig{RAM: MinRAM - 1}.Validate())
+	assert.Error(t, ModifyVMConfig{RAM: MaxRAM + 1}.Validate())
+}
+
+func TestModifyVM(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, "4", r.Form.Get("vcpu"))
+		assert.Equal(t, "4096", r.Form.Get("ram"))
+		assert.NotContains(t, r.Form, "name")
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+
+	// invalid config is not sent
+	_, err := vm.ModifyVM(context.Background(), id, ModifyVMConfig{VCPU: 100})
+	assert.Error(t, err)
+
+	vm.ModifyVM(context.Background(), id, ModifyVMConfig{VCPU: 4, RAM: 4096})
+}
+
+func TestRenameVM(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, "web-01", r.Form.Get("name"))
+		w.Write([]byte(`{"name":"web-01"}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+
+	_, err := vm.RenameVM(context.Background(), id, "")
+	assert.EqualError(t, err, `Name with value of "" is invalid`)
+
+	renamed, err := vm.RenameVM(context.Background(), id, "web-01")
+	assert.NoError(t, err)
+	assert.Equal(t, "web-01", renamed.Name)
+}
+
+func TestUpdateVMDescription(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		// sent even when empty so description can be cleared
+		assert.Contains(t, r.Form, "description")
+		w.Write([]byte(fmt.Sprintf(`{"description":%q}`, r.Form.Get("description"))))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	updated, err := vm.UpdateVMDescription(context.Background(), id, "CMDB-1234")
+	assert.NoError(t, err)
+	assert.Equal(t, "CMDB-1234", updated.Description)
+
+	updated, err = vm.UpdateVMDescription(context.Background(), id, "")
+	assert.NoError(t, err)
+	assert.Empty(t, updated.Description)
+}
+
+func TestUpdateVMMetadata(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/metadata", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, `{"env":"prod"}`, r.Form.Get("metadata"))
+		w.Write([]byte(`{"metadata":{"env":"prod"}}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	updated, err := vm.UpdateVMMetadata(context.Background(), id, map[string]string{"env": "prod"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "prod"}, updated.Metadata)
+}
+
+func TestValidatePassword(t *testing.T) {
+	assert.NoError(t, ValidatePassword("Secret123"))
+	for _, p := range []string{"Sec123", "secret123", "SECRET123", "SecretPass", "Sé1éééé"} {
+		assert.EqualError(t, ValidatePassword(p), "Password is invalid, must be at least 8 characters and contain uppercase letter, lowercase letter and digit")
+	}
+}
+
+func TestResetVMPassword(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/password", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, "NewSecret123", r.Form.Get("password"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	assert.NoError(t, vm.ResetVMPassword(context.Background(), id, "NewSecret123"))
+}
+
+func TestResetVMPassword_Weak(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not be sent")
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	assert.Error(t, vm.ResetVMPassword(context.Background(), id, "weak"))
+}
+
+func TestCloneVM(t *testing.T) {
+	cloneID := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/clone", loc), r.RequestURI)
+
+		_ = r.ParseForm()
+		assert.Equal(t, id.String(), r.Form.Get("uuid"))
+		assert.Equal(t, "test-clone", r.Form.Get("name"))
+
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","name":"test-clone","status":"creating"}`, cloneID)))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	clone, err := vm.CloneVM(context.Background(), id, "test-clone")
+	assert.NoError(t, err)
+	assert.Equal(t, cloneID, clone.UUID)
+	assert.Equal(t, "test-clone", clone.Name)
+}
+
+func TestGetVMConsole(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/console?uuid=%s", loc, id), r.RequestURI)
+		w.Write([]byte(`{"url":"https://console.example/vnc?token=abc","token":"abc"}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	console, err := vm.GetVMConsole(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", console.Token)
+}
+
+func TestGetVMConsoleURL(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"url":"https://console.example/vnc?token=abc","token":"abc"}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	u, err := vm.GetVMConsoleURL(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://console.example/vnc?token=abc", u)
+}
+
+func TestWaitForVMStatus(t *testing.T) {
+	statuses := []string{StatusCreating, StatusStarting, StatusRunning}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm?uuid=%s", loc, id), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, id, statuses[0])))
+		statuses = statuses[1:]
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	res, err := vm.WaitForVMStatus(context.Background(), id, StatusRunning, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, StatusRunning, res.Status)
+}
+
+func TestShutdownVM(t *testing.T) {
+	var calls []string
+	statuses := []string{StatusStopping, StatusStopped}
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		if r.Method == "GET" {
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, id, statuses[0])))
+			statuses = statuses[1:]
+			return
+		}
+		w.Write([]byte(`{"status":"stopping"}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	res, err := vm.ShutdownVM(context.Background(), id, time.Second, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, StatusStopped, res.Status)
+	// stopped gracefully, no power off
+	assert.NotContains(t, calls, fmt.Sprintf("POST /v1/%s/user-resource/vm/poweroff", loc))
+}
+
+func TestShutdownVM_NilContext(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, id, StatusStopped)))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	res, err := vm.ShutdownVM(nil, id, time.Second, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, StatusStopped, res.Status)
+}
+
+func TestShutdownVM_PowerOff(t *testing.T) {
+	poweredOff := false
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case fmt.Sprintf("/v1/%s/user-resource/vm/poweroff", loc):
+			poweredOff = true
+		case fmt.Sprintf("/v1/%s/user-resource/vm", loc):
+			// guest ignores ACPI shutdown
+			status := StatusRunning
+			if poweredOff {
+				status = StatusStopped
+			}
+			w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","status":"%s"}`, id, status)))
+			return
+		}
+		w.Write([]byte(`{}`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	res, err := vm.ShutdownVM(context.Background(), id, 20*time.Millisecond, waiter.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.True(t, poweredOff)
+	assert.Equal(t, StatusStopped, res.Status)
+}
+
+func TestShutdownVM_Canceled(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		// power off is never attempted when caller gave up
+		assert.NotEqual(t, fmt.Sprintf("/v1/%s/user-resource/vm/poweroff", loc), r.URL.Path)
+		w.Write([]byte(`{"status":"running"}`))
+	})
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	vm := Client{API: a, Location: loc}
+	_, err := vm.ShutdownVM(ctx, id, time.Minute, waiter.WithInterval(time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestListVMsIterator(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/user-resource/vm/list?limit=2&page=1", loc), r.RequestURI)
+		w.Write([]byte("[{}]"))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	it := vm.ListVMsIterator(2)
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
+
+func TestListVMsIterator_PaginationIgnored(t *testing.T) {
+	calls := 0
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`[{"uuid":"0eb8ef70-1c68-4d4f-8ea0-b1b5d4e1b8e4"},{"uuid":"5ae4b5d4-dd0c-4c54-a3f9-1e1b5d4e1b8f"}]`))
+	})
+	defer s.Close()
+
+	vm := Client{API: a, Location: loc}
+	var got []VM
+	err := vm.ListVMsIterator(2).ForEach(context.Background(), func(v VM) error {
+		got = append(got, v)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+	assert.Equal(t, 2, calls)
+}
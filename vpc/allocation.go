@@ -0,0 +1,50 @@
+package vpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/google/uuid"
+)
+
+// ListIPAllocations https://api.warren.io/#list-network-ip-addresses
+func (c *Client) ListIPAllocations(ctx context.Context, id uuid.UUID) (*[]IPAllocation, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   fmt.Sprintf("/v1/%s/network/network/%s/ip_addresses", c.Location, id),
+	}
+	var allocations []IPAllocation
+	if err := c.API.JSONRequest(ctx, rc).Into(&allocations); err != nil {
+		return nil, err
+	}
+	return &allocations, nil
+}
+
+// ReservePrivateIP https://api.warren.io/#reserve-network-ip-address
+// Reserved address can then be given to a VM at creation time, see `vm.CreateVMConfig.PrivateIPv4`.
+func (c *Client) ReservePrivateIP(ctx context.Context, id uuid.UUID, address string) (*IPAllocation, error) {
+	if ip := net.ParseIP(address); ip == nil || ip.To4() == nil {
+		return nil, fmt.Errorf("address with value of %v is invalid", address)
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   fmt.Sprintf("/v1/%s/network/network/%s/ip_addresses", c.Location, id),
+		JSON:   map[string]interface{}{"address": address},
+	}
+	var allocation IPAllocation
+	if err := c.API.JSONRequest(ctx, rc).Into(&allocation); err != nil {
+		return nil, err
+	}
+	return &allocation, nil
+}
+
+// ReleasePrivateIP https://api.warren.io/#release-network-ip-address
+func (c *Client) ReleasePrivateIP(ctx context.Context, id uuid.UUID, address string) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/%s/network/network/%s/ip_addresses/%s", c.Location, id, address),
+	}
+	return c.API.JSONRequest(ctx, rc).Error
+}
@@ -0,0 +1,62 @@
+package vpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListIPAllocations(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/network/%s/ip_addresses", loc, id), r.RequestURI)
+		w.Write([]byte(`[{"address":"10.0.0.10","reserved":true,"vm_uuid":null}]`))
+	})
+	defer s.Close()
+
+	vpc := Client{API: a, Location: loc}
+	allocations, err := vpc.ListIPAllocations(context.Background(), id)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.10", (*allocations)[0].Address)
+	assert.False(t, (*allocations)[0].VMUUID.Valid)
+}
+
+func TestReservePrivateIP(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/network/%s/ip_addresses", loc, id), r.RequestURI)
+
+		var data map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&data)
+		assert.Equal(t, "10.0.0.10", data["address"])
+		w.Write([]byte(`{"address":"10.0.0.10","reserved":true}`))
+	})
+	defer s.Close()
+
+	vpc := Client{API: a, Location: loc}
+	allocation, err := vpc.ReservePrivateIP(context.Background(), id, "10.0.0.10")
+	assert.NoError(t, err)
+	assert.True(t, allocation.Reserved)
+}
+
+func TestReservePrivateIP_Invalid(t *testing.T) {
+	vpc := Client{Location: loc}
+	_, err := vpc.ReservePrivateIP(context.Background(), id, "10.0.0")
+	assert.EqualError(t, err, "address with value of 10.0.0 is invalid")
+}
+
+func TestReleasePrivateIP(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/network/%s/ip_addresses/10.0.0.10", loc, id), r.RequestURI)
+	})
+	defer s.Close()
+
+	vpc := Client{API: a, Location: loc}
+	vpc.ReleasePrivateIP(context.Background(), id, "10.0.0.10")
+}
@@ -1,6 +1,8 @@
 package vpc
 
 import (
+	"fmt"
+
 	"github.com/ekaputra07/warren-go/api"
 	"github.com/google/uuid"
 )
@@ -10,6 +12,10 @@ type Client struct {
 	Location string
 }
 
+// Network is a private network (VPC)
+type Network = NetworkInfo
+
+// NetworkInfo represents private network as returned by the API, see `Network`
 type NetworkInfo struct {
 	VLANID        int        `json:"vlan_id"`
 	UUID          uuid.UUID  `json:"uuid"`
@@ -23,3 +29,38 @@ type NetworkInfo struct {
 	CreatedAt     string     `json:"created_at"`
 	UpdatedAt     string     `json:"updated_at"`
 }
+
+// HasVM tells whether VM with given UUID is connected to network.
+func (n NetworkInfo) HasVM(id uuid.UUID) bool {
+	for _, v := range n.VMUUIDs {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// NetworkInUseError is returned by `Client.DeleteNetwork()` when network can't be deleted because VMs are
+// still connected to it, detach or delete them first. It wraps the API error so `api.ErrConflict` etc. still match.
+type NetworkInUseError struct {
+	NetworkUUID uuid.UUID
+	VMUUIDs     uuid.UUIDs
+	Err         error
+}
+
+func (e *NetworkInUseError) Error() string {
+	return fmt.Sprintf("network %s is used by %d VM(s) %v: %v", e.NetworkUUID, len(e.VMUUIDs), e.VMUUIDs.Strings(), e.Err)
+}
+
+func (e *NetworkInUseError) Unwrap() error {
+	return e.Err
+}
+
+// IPAllocation is a private IP address used or reserved within network
+type IPAllocation struct {
+	Address     string        `json:"address"`
+	NetworkUUID uuid.UUID     `json:"network_uuid"`
+	VMUUID      uuid.NullUUID `json:"vm_uuid"`
+	Reserved    bool          `json:"reserved"`
+	CreatedAt   string        `json:"created_at"`
+}
@@ -2,7 +2,7 @@ package vpc
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 
@@ -23,35 +23,45 @@ func (c *Client) ListNetworks(ctx context.Context) (*[]NetworkInfo, error) {
 		Method: "GET",
 		Path:   fmt.Sprintf("/v1/%s/network/networks", c.Location),
 	}
-	res := c.API.JSONRequest(ctx, rc)
-	if res.Error != nil {
-		return nil, res.Error
-	}
 	var i []NetworkInfo
-	if err := json.Unmarshal(res.Body, &i); err != nil {
+	if err := c.API.JSONRequest(ctx, rc).Into(&i); err != nil {
 		return nil, err
 	}
 	return &i, nil
 }
 
+// ListNetworksIterator returns iterator over `ListNetworks()` results, fetching limit items per page.
+func (c *Client) ListNetworksIterator(limit int) *api.Iterator[NetworkInfo] {
+	return api.NewIterator(func(ctx context.Context, page, limit int) ([]NetworkInfo, error) {
+		rc := api.RequestConfig{
+			Method: "GET",
+			Path:   fmt.Sprintf("/v1/%s/network/networks", c.Location),
+			Page:   page,
+			Limit:  limit,
+		}
+		var networks []NetworkInfo
+		if err := c.API.JSONRequest(ctx, rc).Into(&networks); err != nil {
+			return nil, err
+		}
+		return networks, nil
+	}, limit)
+}
+
 // GetNetwork https://api.warren.io/#get-network-data
 func (c *Client) GetNetwork(ctx context.Context, id uuid.UUID) (*NetworkInfo, error) {
 	rc := api.RequestConfig{
 		Method: "GET",
 		Path:   fmt.Sprintf("/v1/%s/network/network/%s", c.Location, id),
 	}
-	res := c.API.JSONRequest(ctx, rc)
-	if res.Error != nil {
-		return nil, res.Error
-	}
 	var i NetworkInfo
-	if err := json.Unmarshal(res.Body, &i); err != nil {
+	if err := c.API.JSONRequest(ctx, rc).Into(&i); err != nil {
 		return nil, err
 	}
 	return &i, nil
 }
 
 // DeleteNetwork https://api.warren.io/#delete-network
+// When the API refuses to delete network that has VMs connected, `*NetworkInUseError` listing them is returned.
 func (c *Client) DeleteNetwork(ctx context.Context, id uuid.UUID) error {
 	rc := api.RequestConfig{
 		Method: "DELETE",
@@ -59,7 +69,15 @@ func (c *Client) DeleteNetwork(ctx context.Context, id uuid.UUID) error {
 	}
 	res := c.API.JSONRequest(ctx, rc)
 	if res.Error != nil {
-		return res.Error
+		if !errors.Is(res.Error, api.ErrConflict) && !errors.Is(res.Error, api.ErrBadRequest) {
+			return res.Error
+		}
+		// the API doesn't tell why, find out whether it's because of connected VMs
+		n, err := c.GetNetwork(ctx, id)
+		if err != nil || len(n.VMUUIDs) == 0 {
+			return res.Error
+		}
+		return &NetworkInUseError{NetworkUUID: id, VMUUIDs: n.VMUUIDs, Err: res.Error}
 	}
 	return nil
 }
@@ -85,12 +103,8 @@ func (c *Client) GetOrCreateDefaultNetwork(ctx context.Context, name string) (*N
 		Path:   fmt.Sprintf("/v1/%s/network/network", c.Location),
 		Query:  url.Values{"name": []string{name}},
 	}
-	res := c.API.JSONRequest(ctx, rc)
-	if res.Error != nil {
-		return nil, res.Error
-	}
 	var i NetworkInfo
-	if err := json.Unmarshal(res.Body, &i); err != nil {
+	if err := c.API.JSONRequest(ctx, rc).Into(&i); err != nil {
 		return nil, err
 	}
 	return &i, nil
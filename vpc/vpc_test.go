@@ -3,6 +3,7 @@ package vpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -50,6 +51,43 @@ func TestDeleteNetwork(t *testing.T) {
 	vpc.DeleteNetwork(context.Background(), id)
 }
 
+func TestDeleteNetwork_InUse(t *testing.T) {
+	vmID := uuid.New()
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/network/%s", loc, id), r.RequestURI)
+		w.Write([]byte(fmt.Sprintf(`{"uuid":"%s","vm_uuids":["%s"]}`, id, vmID)))
+	})
+	defer s.Close()
+
+	vpc := Client{API: a, Location: loc}
+	err := vpc.DeleteNetwork(context.Background(), id)
+
+	var inUse *NetworkInUseError
+	assert.True(t, errors.As(err, &inUse))
+	assert.Equal(t, uuid.UUIDs{vmID}, inUse.VMUUIDs)
+	assert.ErrorIs(t, err, api.ErrConflict)
+	assert.Contains(t, err.Error(), vmID.String())
+}
+
+func TestDeleteNetwork_OtherError(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		// network is not looked up for unrelated errors
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer s.Close()
+
+	vpc := Client{API: a, Location: loc}
+	err := vpc.DeleteNetwork(context.Background(), id)
+	assert.ErrorIs(t, err, api.ErrNotFound)
+	var inUse *NetworkInUseError
+	assert.False(t, errors.As(err, &inUse))
+}
+
 func TestRenameNetwork(t *testing.T) {
 	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "PATCH", r.Method)
@@ -86,3 +124,24 @@ func TestSetDefaultNetwork(t *testing.T) {
 	vpc := Client{API: a, Location: loc}
 	vpc.SetDefaultNetwork(context.Background(), id)
 }
+
+func TestListNetworksIterator(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, fmt.Sprintf("/v1/%s/network/networks?limit=2&page=1", loc), r.RequestURI)
+		w.Write([]byte("[{}]"))
+	})
+	defer s.Close()
+
+	vpc := Client{API: a, Location: loc}
+	it := vpc.ListNetworksIterator(2)
+	assert.True(t, it.Next(context.Background()))
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
+
+func TestNetworkInfo_HasVM(t *testing.T) {
+	n := Network{VMUUIDs: uuid.UUIDs{id}}
+	assert.True(t, n.HasVM(id))
+	assert.False(t, n.HasVM(uuid.New()))
+}
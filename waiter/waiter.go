@@ -0,0 +1,120 @@
+// Package waiter polls asynchronous resources until they reach the desired state.
+package waiter
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultInterval    = 2 * time.Second
+	defaultMaxInterval = 30 * time.Second
+	backoffFactor      = 1.5
+)
+
+type config struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	onPoll      func(status string)
+}
+
+// Option configures polling behaviour.
+type Option func(*config)
+
+// WithInterval sets delay before the first re-check, default to 2s. Non-positive d is ignored.
+func WithInterval(d time.Duration) Option {
+	return func(c *config) {
+		if d > 0 {
+			c.interval = d
+		}
+	}
+}
+
+// WithMaxInterval caps the delay between checks as it grows, default to 30s. Non-positive d is ignored.
+func WithMaxInterval(d time.Duration) Option {
+	return func(c *config) {
+		if d > 0 {
+			c.maxInterval = d
+		}
+	}
+}
+
+// WithOnPoll sets fn to be called with the status fetched on every check made by `ForStatus()`,
+// e.g. to print progress or emit events while waiting. It's called from the waiting goroutine.
+func WithOnPoll(fn func(status string)) Option {
+	return func(c *config) {
+		c.onPoll = fn
+	}
+}
+
+// ConditionFunc reports whether waiting is done, returning an error stops waiting immediately.
+type ConditionFunc func(ctx context.Context) (done bool, err error)
+
+// Until calls fn repeatedly, with growing delay in between, until it's done, fails or ctx is done.
+// Use `context.WithTimeout()` to limit how long to wait, nil ctx means `context.Background()`.
+func Until(ctx context.Context, fn ConditionFunc, opts ...Option) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	cfg := config{interval: defaultInterval, maxInterval: defaultMaxInterval}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	interval := cfg.interval
+	for {
+		done, err := fn(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+
+		interval = time.Duration(float64(interval) * backoffFactor)
+		if interval > cfg.maxInterval {
+			interval = cfg.maxInterval
+		}
+	}
+}
+
+// StatusFunc returns the current status of a resource.
+type StatusFunc func(ctx context.Context) (string, error)
+
+// ForStatus waits until fn returns target status.
+func ForStatus(ctx context.Context, fn StatusFunc, target string, opts ...Option) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	var cfg config
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var last string
+	err := Until(ctx, func(ctx context.Context) (bool, error) {
+		status, err := fn(ctx)
+		if err != nil {
+			return false, err
+		}
+		last = status
+		if cfg.onPoll != nil {
+			cfg.onPoll(status)
+		}
+		return status == target, nil
+	}, opts...)
+
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("waiting for status %q (last status %q): %w", target, last, err)
+	}
+	return err
+}
@@ -0,0 +1,94 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUntil(t *testing.T) {
+	calls := 0
+	err := Until(context.Background(), func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 3, nil
+	}, WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestUntil_NilContext(t *testing.T) {
+	calls := 0
+	err := Until(nil, func(ctx context.Context) (bool, error) {
+		calls++
+		return calls == 2, nil
+	}, WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestUntil_Error(t *testing.T) {
+	calls := 0
+	err := Until(context.Background(), func(ctx context.Context) (bool, error) {
+		calls++
+		return false, errors.New("failed")
+	}, WithInterval(time.Millisecond))
+	assert.EqualError(t, err, "failed")
+	assert.Equal(t, 1, calls)
+}
+
+func TestUntil_ContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := Until(ctx, func(ctx context.Context) (bool, error) {
+		return false, nil
+	}, WithInterval(time.Millisecond), WithMaxInterval(2*time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithInterval_NonPositive(t *testing.T) {
+	cfg := config{interval: defaultInterval, maxInterval: defaultMaxInterval}
+	for _, opt := range []Option{WithInterval(0), WithMaxInterval(-time.Second)} {
+		opt(&cfg)
+	}
+	assert.Equal(t, defaultInterval, cfg.interval)
+	assert.Equal(t, defaultMaxInterval, cfg.maxInterval)
+}
+
+func TestForStatus(t *testing.T) {
+	statuses := []string{"creating", "creating", "running"}
+	err := ForStatus(context.Background(), func(ctx context.Context) (string, error) {
+		s := statuses[0]
+		statuses = statuses[1:]
+		return s, nil
+	}, "running", WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+}
+
+func TestForStatus_OnPoll(t *testing.T) {
+	statuses := []string{"creating", "starting", "running"}
+	var polled []string
+	err := ForStatus(context.Background(), func(ctx context.Context) (string, error) {
+		s := statuses[0]
+		statuses = statuses[1:]
+		return s, nil
+	}, "running", WithInterval(time.Millisecond), WithOnPoll(func(status string) {
+		polled = append(polled, status)
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"creating", "starting", "running"}, polled)
+}
+
+func TestForStatus_Timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := ForStatus(ctx, func(ctx context.Context) (string, error) {
+		return "creating", nil
+	}, "running", WithInterval(time.Millisecond))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, `last status "creating"`)
+}
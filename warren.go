@@ -1,44 +1,120 @@
 package warren
 
 import (
+	"github.com/ekaputra07/warren-go/account"
 	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/billing"
 	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/ekaputra07/warren-go/config"
+	"github.com/ekaputra07/warren-go/dns"
+	"github.com/ekaputra07/warren-go/events"
+	"github.com/ekaputra07/warren-go/firewall"
+	"github.com/ekaputra07/warren-go/image"
 	"github.com/ekaputra07/warren-go/ip"
+	"github.com/ekaputra07/warren-go/kubernetes"
+	"github.com/ekaputra07/warren-go/lb"
 	"github.com/ekaputra07/warren-go/location"
 	"github.com/ekaputra07/warren-go/objectstorage"
+	"github.com/ekaputra07/warren-go/sshkey"
+	"github.com/ekaputra07/warren-go/token"
+	"github.com/ekaputra07/warren-go/vm"
 	"github.com/ekaputra07/warren-go/vpc"
+	"github.com/ekaputra07/warren-go/webhook"
 )
 
-// Warren a single object to access all APIs
+// Warren a single object to access all APIs.
+// Modules are typed as service interfaces (see service.go) so they can be replaced, e.g. by `mocks` in tests.
+// BillingAccountID is the default billing account from profile, see `NewClientFromProfile()`.
 type Warren struct {
-	Location      *location.Client
-	ObjectStorage *objectstorage.Client
-	BlockStorage  *blockstorage.Client
-	VPC           *vpc.Client
-	IP            *ip.Client
+	API           *api.API
+	Location      LocationService
+	ObjectStorage ObjectStorageService
+	BlockStorage  DiskService
+	VPC           VPCService
+	IP            FloatingIPService
+	VM            VMService
+	LoadBalancer  LoadBalancerService
+	Kubernetes    KubernetesService
+	Billing       BillingService
+	SSHKey        SSHKeyService
+	Image         ImageService
+	Token         TokenService
+	Account       AccountService
+	Events        EventsService
+	Webhook       WebhookService
+	Firewall      FirewallService
+	DNS           DNSService
+
+	BillingAccountID int
 }
 
 // Init initialize Warren with given API client
 func Init(api *api.API, loc string) *Warren {
 	return &Warren{
+		API:           api,
 		Location:      location.NewClient(api),
 		ObjectStorage: objectstorage.NewClient(api),
 		BlockStorage:  blockstorage.NewClient(api),
 		VPC:           vpc.NewClient(api, loc),
 		IP:            ip.NewClient(api, loc),
+		VM:            vm.NewClient(api, loc),
+		LoadBalancer:  lb.NewClient(api, loc),
+		Kubernetes:    kubernetes.NewClient(api, loc),
+		Billing:       billing.NewClient(api),
+		SSHKey:        sshkey.NewClient(api),
+		Image:         image.NewClient(api),
+		Token:         token.NewClient(api),
+		Account:       account.NewClient(api),
+		Events:        events.NewClient(api),
+		Webhook:       webhook.NewClient(api),
+		Firewall:      firewall.NewClient(api, loc),
+		DNS:           dns.NewClient(api),
+	}
+}
+
+// WithLocation returns a copy of Warren sharing the same API client, with location-scoped modules targeting given location.
+// Use it to manage resources in multiple data centers:
+//
+//	jkt := w.WithLocation("jkt01")
+//	sgp := w.WithLocation("sgp01")
+func (w *Warren) WithLocation(location string) *Warren {
+	c := Init(w.API, location)
+	c.BillingAccountID = w.BillingAccountID
+	return c
+}
+
+// NewClient returns Warren where all modules share a single API client created from given base URL, API key and options.
+// Location is only required by resources that live in a datacenter such as vpc, ip, vm, lb, kubernetes, firewall.
+func NewClient(baseURL, apiKey, location string, opts ...api.Option) *Warren {
+	return Init(api.New(baseURL, apiKey, opts...), location)
+}
+
+// NewClientFromProfile returns Warren configured from named profile in config file (see `config.Load()`),
+// empty name means the default profile.
+func NewClientFromProfile(name string, opts ...api.Option) (*Warren, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	p, err := cfg.Profile(name)
+	if err != nil {
+		return nil, err
 	}
+	w := NewClient(p.BaseURL, p.APIKey, p.Location, opts...)
+	w.BillingAccountID = p.BillingAccountID
+	return w, nil
 }
 
 // New returns Warren that initialized with Default API client.
 // Use this if you want to manage resources that doesn't require datacenter location such as:
-// location, objectstorage, blockstorage
+// location, objectstorage, blockstorage, billing, sshkey, image, token, account, events, webhook, dns
 func New() *Warren {
 	return Init(api.Default, "")
 }
 
 // New returns Warren that initialized with Default API client and specified location.
 // Use this if you want to manage resources that require datacenter location such as:
-// vpc, ip
+// vpc, ip, vm, lb, kubernetes, firewall
 func NewWithLocation(location string) *Warren {
 	return Init(api.Default, location)
 }
@@ -0,0 +1,89 @@
+package warren
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/account"
+	"github.com/ekaputra07/warren-go/billing"
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/ekaputra07/warren-go/dns"
+	"github.com/ekaputra07/warren-go/events"
+	"github.com/ekaputra07/warren-go/firewall"
+	"github.com/ekaputra07/warren-go/image"
+	"github.com/ekaputra07/warren-go/ip"
+	"github.com/ekaputra07/warren-go/kubernetes"
+	"github.com/ekaputra07/warren-go/lb"
+	"github.com/ekaputra07/warren-go/location"
+	"github.com/ekaputra07/warren-go/objectstorage"
+	"github.com/ekaputra07/warren-go/sshkey"
+	"github.com/ekaputra07/warren-go/token"
+	"github.com/ekaputra07/warren-go/vm"
+	"github.com/ekaputra07/warren-go/vpc"
+	"github.com/ekaputra07/warren-go/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	w := NewClient("https://api.warren.io", "secret", "jkt01")
+	assert.Equal(t, "https://api.warren.io", w.API.BaseURL)
+	assert.Equal(t, "secret", w.API.APIKey)
+
+	// all modules share the same API client
+	assert.Same(t, w.API, w.Location.(*location.Client).API)
+	assert.Same(t, w.API, w.ObjectStorage.(*objectstorage.Client).API)
+	assert.Same(t, w.API, w.BlockStorage.(*blockstorage.Client).API)
+	assert.Same(t, w.API, w.VPC.(*vpc.Client).API)
+	assert.Same(t, w.API, w.IP.(*ip.Client).API)
+	assert.Same(t, w.API, w.VM.(*vm.Client).API)
+	assert.Same(t, w.API, w.LoadBalancer.(*lb.Client).API)
+	assert.Same(t, w.API, w.Kubernetes.(*kubernetes.Client).API)
+	assert.Same(t, w.API, w.Billing.(*billing.Client).API)
+	assert.Same(t, w.API, w.SSHKey.(*sshkey.Client).API)
+	assert.Same(t, w.API, w.Image.(*image.Client).API)
+	assert.Same(t, w.API, w.Token.(*token.Client).API)
+	assert.Same(t, w.API, w.Account.(*account.Client).API)
+	assert.Same(t, w.API, w.Events.(*events.Client).API)
+	assert.Same(t, w.API, w.Webhook.(*webhook.Client).API)
+	assert.Same(t, w.API, w.Firewall.(*firewall.Client).API)
+	assert.Same(t, w.API, w.DNS.(*dns.Client).API)
+
+	assert.Equal(t, "jkt01", w.VPC.(*vpc.Client).Location)
+	assert.Equal(t, "jkt01", w.IP.(*ip.Client).Location)
+	assert.Equal(t, "jkt01", w.VM.(*vm.Client).Location)
+	assert.Equal(t, "jkt01", w.LoadBalancer.(*lb.Client).Location)
+	assert.Equal(t, "jkt01", w.Kubernetes.(*kubernetes.Client).Location)
+	assert.Equal(t, "jkt01", w.Firewall.(*firewall.Client).Location)
+}
+
+func TestWithLocation(t *testing.T) {
+	w := NewClient("https://api.warren.io", "secret", "jkt01")
+	sgp := w.WithLocation("sgp01")
+
+	assert.Same(t, w.API, sgp.API)
+	assert.Equal(t, "jkt01", w.VM.(*vm.Client).Location)
+	assert.Equal(t, "sgp01", sgp.VPC.(*vpc.Client).Location)
+	assert.Equal(t, "sgp01", sgp.IP.(*ip.Client).Location)
+	assert.Equal(t, "sgp01", sgp.VM.(*vm.Client).Location)
+	assert.Equal(t, "sgp01", sgp.LoadBalancer.(*lb.Client).Location)
+	assert.Equal(t, "sgp01", sgp.Kubernetes.(*kubernetes.Client).Location)
+	assert.Equal(t, "sgp01", sgp.Firewall.(*firewall.Client).Location)
+}
+
+func TestNewClientFromProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config")
+	os.WriteFile(path, []byte("[staging]\napi_key = secret\nbase_url = https://staging.example.com\nlocation = sgp01\nbilling_account_id = 123\n"), 0600)
+	t.Setenv("WARREN_CONFIG_FILE", path)
+
+	w, err := NewClientFromProfile("staging")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://staging.example.com", w.API.BaseURL)
+	assert.Equal(t, "secret", w.API.APIKey)
+	assert.Equal(t, "sgp01", w.VM.(*vm.Client).Location)
+	assert.Equal(t, 123, w.BillingAccountID)
+	assert.Equal(t, 123, w.WithLocation("jkt01").BillingAccountID)
+
+	_, err = NewClientFromProfile("")
+	assert.EqualError(t, err, "profile default not found")
+}
@@ -0,0 +1,81 @@
+package warrentest
+
+import (
+	"net/http"
+
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/google/uuid"
+)
+
+// handleDisks serves /v1/storage/disks/{p...}
+func (s *Server) handleDisks(w http.ResponseWriter, r *http.Request, p []string) {
+	f := form(r)
+	if len(p) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, paginate(r, copyAll(s.disks)))
+		case http.MethodPost:
+			d := &blockstorage.Disk{
+				UUID:             uuid.New(),
+				Status:           "ready",
+				BillingAccountID: atoi(f.Get("billing_account_id")),
+				SizeGB:           atoi(f.Get("size_gb")),
+				SourceImageType:  blockstorage.SourceImageType(f.Get("source_image_type")),
+				SourceImage:      f.Get("source_image"),
+				CreatedAt:        now(),
+				UpdatedAt:        now(),
+			}
+			s.disks = append(s.disks, d)
+			writeJSON(w, http.StatusOK, d)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "method not allowed")
+		}
+		return
+	}
+
+	id, err := uuid.Parse(p[0])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_uuid", "uuid is invalid")
+		return
+	}
+	i := s.findDisk(id)
+	if i < 0 {
+		notFound(w, "disk")
+		return
+	}
+	d := s.disks[i]
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, d)
+	case http.MethodDelete:
+		s.disks = append(s.disks[:i], s.disks[i+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodPatch:
+		if size := atoi(f.Get("size_gb")); size > 0 {
+			d.SizeGB = size
+		}
+		if ba := atoi(f.Get("billing_account_id")); ba > 0 {
+			d.BillingAccountID = ba
+		}
+		if name := f.Get("name"); name != "" {
+			d.Name = name
+		}
+		if desc := f.Get("description"); desc != "" {
+			d.Description = desc
+		}
+		d.UpdatedAt = now()
+		writeJSON(w, http.StatusOK, d)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "method not allowed")
+	}
+}
+
+func (s *Server) findDisk(id uuid.UUID) int {
+	for i, d := range s.disks {
+		if d.UUID == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,89 @@
+package warrentest
+
+import (
+	"net/http"
+
+	"github.com/ekaputra07/warren-go/vpc"
+	"github.com/google/uuid"
+)
+
+// handleNetworks serves /v1/{location}/network/networks and /v1/{location}/network/network/{p...}
+func (s *Server) handleNetworks(w http.ResponseWriter, r *http.Request, p []string) {
+	f := form(r)
+	if p[0] == "networks" {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "method not allowed")
+			return
+		}
+		writeJSON(w, http.StatusOK, paginate(r, copyAll(s.networks)))
+		return
+	}
+
+	if len(p) == 1 {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "method not allowed")
+			return
+		}
+		for _, n := range s.networks {
+			if n.IsDefault {
+				writeJSON(w, http.StatusOK, n)
+				return
+			}
+		}
+		n := &vpc.NetworkInfo{
+			UUID:      uuid.New(),
+			Name:      f.Get("name"),
+			IsDefault: true,
+			CreatedAt: now(),
+			UpdatedAt: now(),
+		}
+		s.networks = append(s.networks, n)
+		writeJSON(w, http.StatusOK, n)
+		return
+	}
+
+	id, err := uuid.Parse(p[1])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_uuid", "uuid is invalid")
+		return
+	}
+	i := s.findNetwork(id)
+	if i < 0 {
+		notFound(w, "network")
+		return
+	}
+	n := s.networks[i]
+
+	switch {
+	case len(p) == 2 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, n)
+	case len(p) == 2 && r.Method == http.MethodDelete:
+		if n.ResourceCount > 0 {
+			writeError(w, http.StatusConflict, "network_in_use", "network still has resources")
+			return
+		}
+		s.networks = append(s.networks[:i], s.networks[i+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	case len(p) == 2 && r.Method == http.MethodPatch:
+		n.Name = f.Get("name")
+		n.UpdatedAt = now()
+		writeJSON(w, http.StatusOK, n)
+	case len(p) == 3 && p[2] == "default" && r.Method == http.MethodPut:
+		for _, other := range s.networks {
+			other.IsDefault = false
+		}
+		n.IsDefault = true
+		writeJSON(w, http.StatusOK, n)
+	default:
+		writeError(w, http.StatusNotFound, "not_found", "unknown endpoint")
+	}
+}
+
+func (s *Server) findNetwork(id uuid.UUID) int {
+	for i, n := range s.networks {
+		if n.UUID == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,183 @@
+package warrentest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+// Mode tells Recorder whether to call the real API or replay fixtures
+type Mode int
+
+const (
+	// ModeReplay serves responses from fixture file without touching the network
+	ModeReplay Mode = iota
+	// ModeRecord calls the real API and saves interactions to fixture file
+	ModeRecord
+)
+
+// Interaction is a single recorded request and its response.
+// Request headers are never recorded and secret body fields (e.g. password, see `api.RedactBody()`)
+// are redacted, other personal data can be masked with `Recorder.Sanitize`.
+type Interaction struct {
+	Method   string `json:"method"`
+	URL      string `json:"url"`
+	Body     string `json:"body,omitempty"`
+	Response struct {
+		StatusCode  int    `json:"status_code"`
+		ContentType string `json:"content_type,omitempty"`
+		Body        string `json:"body"`
+	} `json:"response"`
+}
+
+// Recorder records API interactions to fixture file and replays them, use it as middleware:
+//
+//	rec, err := warrentest.NewRecorder("testdata/vm.json", warrentest.ModeReplay)
+//	a := api.New(baseURL, apiKey, api.WithMiddleware(rec.Middleware()))
+//	...
+//	rec.Save() // in record mode
+//
+// Requests are matched by method, URL path and query, and body (with secret fields redacted the same way
+// as when recorded); each recording is replayed once, in order.
+type Recorder struct {
+	// Sanitize, when set, is called on every interaction before it's saved, after secret fields are redacted.
+	Sanitize func(*Interaction)
+
+	path string
+	mode Mode
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// NewRecorder returns Recorder for fixture file at path, in replay mode the file must exist.
+func NewRecorder(path string, mode Mode) (*Recorder, error) {
+	r := &Recorder{path: path, mode: mode}
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &r.interactions); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.interactions))
+	return r, nil
+}
+
+// Middleware returns `api.Middleware` that records or replays requests.
+func (r *Recorder) Middleware() api.Middleware {
+	return func(next api.RoundTripFunc) api.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			body, err := readRequestBody(req)
+			if err != nil {
+				return nil, err
+			}
+			if r.mode == ModeReplay {
+				return r.replay(req, body)
+			}
+			return r.record(next, req, body)
+		}
+	}
+}
+
+// Save writes recorded interactions to fixture file, it does nothing in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, b, 0644)
+}
+
+func (r *Recorder) record(next api.RoundTripFunc, req *http.Request, body string) (*http.Response, error) {
+	res, err := next(req)
+	if err != nil {
+		return res, err
+	}
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+
+	i := &Interaction{Method: req.Method, URL: req.URL.RequestURI(), Body: redact(body)}
+	i.Response.StatusCode = res.StatusCode
+	i.Response.ContentType = res.Header.Get("Content-Type")
+	i.Response.Body = redact(string(b))
+	if r.Sanitize != nil {
+		r.Sanitize(i)
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, i)
+	r.mu.Unlock()
+	return res, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	url := req.URL.RequestURI()
+	body = redact(body)
+	for n, i := range r.interactions {
+		if r.used[n] || i.Method != req.Method || i.URL != url || i.Body != body {
+			continue
+		}
+		r.used[n] = true
+
+		header := http.Header{}
+		if i.Response.ContentType != "" {
+			header.Set("Content-Type", i.Response.ContentType)
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader([]byte(i.Response.Body))),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, url)
+}
+
+// readRequestBody returns request body and puts it back so it can be sent.
+func readRequestBody(req *http.Request) (string, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return "", nil
+	}
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(b))
+	return string(b), nil
+}
+
+// redact returns body with secret fields redacted.
+func redact(body string) string {
+	return string(api.RedactBody([]byte(body)))
+}
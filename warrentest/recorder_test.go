@@ -0,0 +1,93 @@
+package warrentest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "disks.json")
+
+	// record against fake server
+	s := NewServer()
+	rec, err := NewRecorder(path, ModeRecord)
+	assert.NoError(t, err)
+	rec.Sanitize = func(i *Interaction) {
+		i.Response.Body = strings.ReplaceAll(i.Response.Body, `"billing_account_id":123`, `"billing_account_id":1`)
+	}
+	bs := blockstorage.NewClient(s.API(api.WithMiddleware(rec.Middleware())))
+	created, err := bs.CreateDisk(ctx, blockstorage.CreateDiskConfig{SizeGB: 10, BillingAccountID: 123, SourceImageType: blockstorage.ImageTypeEmpty})
+	assert.NoError(t, err)
+	_, err = bs.GetDisk(ctx, created.UUID)
+	assert.NoError(t, err)
+	assert.NoError(t, rec.Save())
+	s.Close()
+
+	b, _ := os.ReadFile(path)
+	assert.NotContains(t, string(b), "apikey")
+	assert.NotContains(t, string(b), `"billing_account_id":123`)
+
+	// replay without server
+	rec, err = NewRecorder(path, ModeReplay)
+	assert.NoError(t, err)
+	bs = blockstorage.NewClient(api.New("http://unreachable.invalid", "secret", api.WithMiddleware(rec.Middleware())))
+	replayed, err := bs.CreateDisk(ctx, blockstorage.CreateDiskConfig{SizeGB: 10, BillingAccountID: 123, SourceImageType: blockstorage.ImageTypeEmpty})
+	assert.NoError(t, err)
+	assert.Equal(t, created.UUID, replayed.UUID)
+	assert.Equal(t, 1, replayed.BillingAccountID)
+
+	disk, err := bs.GetDisk(ctx, created.UUID)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, disk.SizeGB)
+
+	// every interaction is replayed once
+	_, err = bs.GetDisk(ctx, created.UUID)
+	assert.ErrorContains(t, err, "no recorded interaction for GET /v1/storage/disks/")
+}
+
+func TestNewRecorder_MissingFixture(t *testing.T) {
+	_, err := NewRecorder(filepath.Join(t.TempDir(), "missing.json"), ModeReplay)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestRecorder_Redacted(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "vm.json")
+
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		// server still receives the real password
+		assert.Equal(t, "s3cret", r.Form.Get("password"))
+		w.Write([]byte(`{"uuid":"1","token":"t0ken"}`))
+	})
+	rec, err := NewRecorder(path, ModeRecord)
+	assert.NoError(t, err)
+	a = a.Clone(api.WithMiddleware(rec.Middleware()))
+	rc := api.RequestConfig{Method: "POST", Path: "/v1/user-resource/vm", Data: url.Values{"name": {"web"}, "password": {"s3cret"}}}
+	assert.NoError(t, a.FormRequest(ctx, rc).Error)
+	assert.NoError(t, rec.Save())
+	s.Close()
+
+	b, _ := os.ReadFile(path)
+	assert.NotContains(t, string(b), "s3cret")
+	assert.NotContains(t, string(b), "t0ken")
+	assert.Contains(t, string(b), "password=REDACTED")
+
+	// replayed request with real password still matches
+	rec, err = NewRecorder(path, ModeReplay)
+	assert.NoError(t, err)
+	a = api.New("http://unreachable.invalid", "secret", api.WithMiddleware(rec.Middleware()))
+	var v struct{ Token string }
+	assert.NoError(t, a.FormRequest(ctx, rc).Into(&v))
+	assert.Equal(t, api.Redacted, v.Token)
+}
@@ -0,0 +1,140 @@
+package warrentest
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/ekaputra07/warren-go/vm"
+	"github.com/google/uuid"
+)
+
+// handleVMs serves /v1/{location}/user-resource/vm/{p...}
+func (s *Server) handleVMs(w http.ResponseWriter, r *http.Request, p []string) {
+	f := form(r)
+	action := ""
+	if len(p) > 0 {
+		action = p[0]
+	}
+
+	if action == "list" && r.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, paginate(r, copyAll(s.vms)))
+		return
+	}
+	if action == "" && r.Method == http.MethodPost {
+		writeJSON(w, http.StatusOK, s.createVM(f))
+		return
+	}
+
+	id, err := uuid.Parse(f.Get("uuid"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_uuid", "uuid is invalid")
+		return
+	}
+	i := s.findVM(id)
+	if i < 0 {
+		notFound(w, "vm")
+		return
+	}
+	v := s.vms[i]
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+	case action == "" && r.Method == http.MethodDelete:
+		s.vms = append(s.vms[:i], s.vms[i+1:]...)
+		for _, st := range v.Storage {
+			if j := s.findDisk(st.UUID); j >= 0 && st.Primary {
+				s.disks = append(s.disks[:j], s.disks[j+1:]...)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case action == "" && r.Method == http.MethodPatch:
+		if name := f.Get("name"); name != "" {
+			v.Name = name
+		}
+		if vcpu := atoi(f.Get("vcpu")); vcpu > 0 {
+			v.VCPU = vcpu
+		}
+		if ram := atoi(f.Get("ram")); ram > 0 {
+			v.Memory = ram
+		}
+		if desc, ok := f["description"]; ok {
+			v.Description = desc[0]
+		}
+		v.UpdatedAt = now()
+	case (action == "start" || action == "reboot") && r.Method == http.MethodPost:
+		v.Status = vm.StatusRunning
+	case action == "stop" && r.Method == http.MethodPost:
+		v.Status = vm.StatusStopped
+	case action == "clone" && r.Method == http.MethodPost:
+		clone := *v
+		writeJSON(w, http.StatusOK, s.clone(&clone, f.Get("name")))
+		return
+	default:
+		writeError(w, http.StatusNotFound, "not_found", "unknown endpoint")
+		return
+	}
+	writeJSON(w, http.StatusOK, v)
+}
+
+func (s *Server) createVM(f url.Values) *vm.VM {
+	name := f.Get("name")
+	v := &vm.VM{
+		UUID:             uuid.New(),
+		Name:             name,
+		Hostname:         name,
+		Description:      f.Get("description"),
+		Status:           vm.StatusRunning,
+		Username:         f.Get("username"),
+		VCPU:             atoi(f.Get("vcpu")),
+		Memory:           atoi(f.Get("ram")),
+		OSName:           f.Get("os_name"),
+		OSVersion:        f.Get("os_version"),
+		BillingAccountID: atoi(f.Get("billing_account_id")),
+		CreatedAt:        now(),
+		UpdatedAt:        now(),
+	}
+	v.Storage = []vm.Storage{s.addPrimaryDisk(atoi(f.Get("disks")), v.BillingAccountID)}
+	s.vms = append(s.vms, v)
+	return v
+}
+
+func (s *Server) clone(v *vm.VM, name string) *vm.VM {
+	v.UUID = uuid.New()
+	v.Name = name
+	v.Hostname = name
+	v.CreatedAt = now()
+	v.UpdatedAt = now()
+	size := 0
+	if len(v.Storage) > 0 {
+		size = v.Storage[0].Size
+	}
+	v.Storage = []vm.Storage{s.addPrimaryDisk(size, v.BillingAccountID)}
+	s.vms = append(s.vms, v)
+	return v
+}
+
+// addPrimaryDisk creates disk backing VM storage so it can be resolved with blockstorage module
+func (s *Server) addPrimaryDisk(sizeGB, billingAccountID int) vm.Storage {
+	d := &blockstorage.Disk{
+		UUID:             uuid.New(),
+		Status:           "ready",
+		BillingAccountID: billingAccountID,
+		SizeGB:           sizeGB,
+		SourceImageType:  blockstorage.ImageTypeOSBase,
+		CreatedAt:        now(),
+		UpdatedAt:        now(),
+	}
+	s.disks = append(s.disks, d)
+	return vm.Storage{UUID: d.UUID, Primary: true, Size: sizeGB, CreatedAt: d.CreatedAt, UpdatedAt: d.UpdatedAt}
+}
+
+func (s *Server) findVM(id uuid.UUID) int {
+	for i, v := range s.vms {
+		if v.UUID == id {
+			return i
+		}
+	}
+	return -1
+}
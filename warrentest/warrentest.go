@@ -0,0 +1,215 @@
+// Package warrentest provides in-memory fake of the Warren API for testing code that uses this library
+// without real credentials. It currently covers VMs, disks and networks:
+//
+//	s := warrentest.NewServer()
+//	defer s.Close()
+//
+//	w := s.Warren("jkt01")
+//	created, err := w.VM.CreateVM(ctx, cfg)
+//
+// All locations share the same state and every resource becomes ready immediately.
+package warrentest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	warren "github.com/ekaputra07/warren-go"
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/ekaputra07/warren-go/vm"
+	"github.com/ekaputra07/warren-go/vpc"
+	"github.com/google/uuid"
+)
+
+// Server is a fake Warren API server, resources are kept in creation order.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	vms      []*vm.VM
+	disks    []*blockstorage.Disk
+	networks []*vpc.NetworkInfo
+}
+
+// NewServer starts and returns a new Server, caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// API returns client connected to the server, opts are applied after the server's HTTP client is set
+// so they can customize it (e.g. `api.WithTransport()`).
+func (s *Server) API(opts ...api.Option) *api.API {
+	return api.New(s.URL, "test", append([]api.Option{api.WithHTTPClient(s.Client())}, opts...)...)
+}
+
+// Warren returns Warren connected to the server.
+func (s *Server) Warren(location string, opts ...api.Option) *warren.Warren {
+	return warren.Init(s.API(opts...), location)
+}
+
+// AddVM seeds a VM, UUID is generated when not set.
+func (s *Server) AddVM(v vm.VM) vm.VM {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v.UUID == uuid.Nil {
+		v.UUID = uuid.New()
+	}
+	s.vms = append(s.vms, &v)
+	return v
+}
+
+// AddDisk seeds a disk, UUID is generated when not set.
+func (s *Server) AddDisk(d blockstorage.Disk) blockstorage.Disk {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if d.UUID == uuid.Nil {
+		d.UUID = uuid.New()
+	}
+	s.disks = append(s.disks, &d)
+	return d
+}
+
+// AddNetwork seeds a network, UUID is generated when not set.
+func (s *Server) AddNetwork(n vpc.NetworkInfo) vpc.NetworkInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n.UUID == uuid.Nil {
+		n.UUID = uuid.New()
+	}
+	s.networks = append(s.networks, &n)
+	return n
+}
+
+// VMs returns copy of current VMs.
+func (s *Server) VMs() []vm.VM {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyAll(s.vms)
+}
+
+// Disks returns copy of current disks.
+func (s *Server) Disks() []blockstorage.Disk {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyAll(s.disks)
+}
+
+// Networks returns copy of current networks.
+func (s *Server) Networks() []vpc.NetworkInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyAll(s.networks)
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("apikey") == "" {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "missing api key")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// /v1/storage/disks/... or /v1/{location}/...
+	p := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(p) >= 3 && p[1] == "storage" && p[2] == "disks":
+		s.handleDisks(w, r, p[3:])
+	case len(p) >= 4 && p[2] == "user-resource" && p[3] == "vm":
+		s.handleVMs(w, r, p[4:])
+	case len(p) >= 4 && p[2] == "network" && (p[3] == "networks" || p[3] == "network"):
+		s.handleNetworks(w, r, p[3:])
+	default:
+		writeError(w, http.StatusNotFound, "not_found", "unknown endpoint")
+	}
+}
+
+// form returns request parameters from both query string and body, `ParseForm()` ignores DELETE body.
+func form(r *http.Request) url.Values {
+	v := r.URL.Query()
+	b, _ := io.ReadAll(r.Body)
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		var m map[string]interface{}
+		if json.Unmarshal(b, &m) == nil {
+			for k, val := range m {
+				switch val := val.(type) {
+				case string:
+					v.Set(k, val)
+				case float64:
+					v.Set(k, strconv.FormatFloat(val, 'f', -1, 64))
+				case bool:
+					v.Set(k, strconv.FormatBool(val))
+				}
+			}
+		}
+		return v
+	}
+	body, _ := url.ParseQuery(string(b))
+	for k, vals := range body {
+		v[k] = vals
+	}
+	return v
+}
+
+// paginate returns requested page of items when page or limit is set.
+func paginate[T any](r *http.Request, items []T) []T {
+	q := r.URL.Query()
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit <= 0 {
+		return items
+	}
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page <= 0 {
+		page = 1
+	}
+	start := (page - 1) * limit
+	if start >= len(items) {
+		return []T{}
+	}
+	end := start + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end]
+}
+
+func copyAll[T any](items []*T) []T {
+	c := make([]T, 0, len(items))
+	for _, i := range items {
+		c = append(c, *i)
+	}
+	return c
+}
+
+func atoi(s string) int {
+	i, _ := strconv.Atoi(s)
+	return i
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]string{"code": code, "message": message})
+}
+
+func notFound(w http.ResponseWriter, kind string) {
+	writeError(w, http.StatusNotFound, "not_found", kind+" not found")
+}
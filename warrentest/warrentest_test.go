@@ -0,0 +1,130 @@
+package warrentest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/ekaputra07/warren-go/blockstorage"
+	"github.com/ekaputra07/warren-go/vm"
+	"github.com/ekaputra07/warren-go/vpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVM(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+	w := s.Warren("jkt01")
+
+	created, err := w.VM.CreateVM(ctx, vm.CreateVMConfig{Name: "web", OSName: "ubuntu", OSVersion: "20.04", VCPU: 2, RAM: 2048, Disks: 20, Username: "admin", Password: "Secret123"})
+	assert.NoError(t, err)
+	assert.Equal(t, vm.StatusRunning, created.Status)
+
+	stopped, err := w.VM.StopVM(ctx, created.UUID)
+	assert.NoError(t, err)
+	assert.Equal(t, vm.StatusStopped, stopped.Status)
+
+	modified, err := w.VM.ModifyVM(ctx, created.UUID, vm.ModifyVMConfig{VCPU: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, modified.VCPU)
+	assert.Equal(t, 2048, modified.Memory)
+
+	disks, err := w.VM.ListVMDisks(ctx, created.UUID)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, (*disks)[0].SizeGB)
+
+	vms, err := w.VM.ListVMs(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, *vms, 1)
+
+	assert.NoError(t, w.VM.DeleteVM(ctx, created.UUID))
+	_, err = w.VM.GetVM(ctx, created.UUID)
+	assert.ErrorIs(t, err, api.ErrNotFound)
+	assert.Empty(t, s.Disks())
+}
+
+func TestDisk(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+	w := s.Warren("")
+
+	created, err := w.BlockStorage.CreateDisk(ctx, blockstorage.CreateDiskConfig{SizeGB: 10, SourceImageType: blockstorage.ImageTypeEmpty})
+	assert.NoError(t, err)
+
+	resized, err := w.BlockStorage.ResizeDisk(ctx, created.UUID, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, resized.SizeGB)
+
+	s.AddDisk(blockstorage.Disk{SizeGB: 5})
+	it := w.BlockStorage.ListDisksIterator(1)
+	n := 0
+	for it.Next(ctx) {
+		n++
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 2, n)
+
+	assert.NoError(t, w.BlockStorage.DeleteDisk(ctx, created.UUID))
+	assert.Len(t, s.Disks(), 1)
+}
+
+func TestNetwork(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+	w := s.Warren("jkt01")
+
+	def, err := w.VPC.GetOrCreateDefaultNetwork(ctx, "default")
+	assert.NoError(t, err)
+	assert.True(t, def.IsDefault)
+
+	again, err := w.VPC.GetOrCreateDefaultNetwork(ctx, "other")
+	assert.NoError(t, err)
+	assert.Equal(t, def.UUID, again.UUID)
+
+	other := s.AddNetwork(vpc.NetworkInfo{Name: "private"})
+	assert.NoError(t, w.VPC.RenameNetwork(ctx, other.UUID, "renamed"))
+	assert.NoError(t, w.VPC.SetDefaultNetwork(ctx, other.UUID))
+
+	n, err := w.VPC.GetNetwork(ctx, other.UUID)
+	assert.NoError(t, err)
+	assert.Equal(t, "renamed", n.Name)
+	assert.True(t, n.IsDefault)
+
+	busy := s.AddNetwork(vpc.NetworkInfo{ResourceCount: 1})
+	assert.ErrorIs(t, w.VPC.DeleteNetwork(ctx, busy.UUID), api.ErrConflict)
+}
+
+func TestUnauthorized(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	a := s.API()
+	a.APIKey = ""
+	resp := a.FormRequest(context.Background(), api.RequestConfig{Method: "GET", Path: "/v1/storage/disks"})
+	assert.ErrorIs(t, resp.Error, api.ErrUnauthorized)
+}
+
+func TestServerAPI_Options(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	calls := 0
+	rt := roundTripper(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return http.DefaultTransport.RoundTrip(r)
+	})
+	w := s.Warren("jkt01", api.WithTransport(rt))
+	_, err := w.BlockStorage.ListDisks(context.Background(), blockstorage.ListDisksOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+type roundTripper func(*http.Request) (*http.Response, error)
+
+func (f roundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
@@ -0,0 +1,65 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+type Client struct {
+	API *api.API
+}
+
+// Events webhook can be subscribed to
+const (
+	EventVMStatusChanged   = "vm.status_changed"
+	EventDiskStatusChanged = "disk.status_changed"
+	EventResourceCreated   = "resource.created"
+	EventResourceDeleted   = "resource.deleted"
+	EventLowBalance        = "billing.low_balance"
+)
+
+// Webhook is an URL the platform posts notifications to when subscribed events happen.
+// Secret is used by the platform to sign the payload, it's only returned when webhook is created.
+type Webhook struct {
+	ID        int      `json:"id"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	Secret    string   `json:"secret"`
+	Enabled   bool     `json:"enabled"`
+	CreatedAt string   `json:"created_at"`
+}
+
+// CreateWebhookConfig holds parameters to create a new webhook
+type CreateWebhookConfig struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+// Validate checks fields that are required by the API, it's called by `Client.CreateWebhook()` before sending the request.
+func (cfg CreateWebhookConfig) Validate() error {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("URL with value of %q is invalid, must be absolute https URL", cfg.URL)
+	}
+	if len(cfg.Events) == 0 {
+		return fmt.Errorf("Events with value of %v is invalid, must not be empty", cfg.Events)
+	}
+	for _, e := range cfg.Events {
+		switch e {
+		case EventVMStatusChanged, EventDiskStatusChanged, EventResourceCreated, EventResourceDeleted, EventLowBalance:
+		default:
+			return fmt.Errorf("Events with value of %v is invalid, %s is unknown", cfg.Events, e)
+		}
+	}
+	return nil
+}
+
+// NotificationPreferences are email notifications of the account
+type NotificationPreferences struct {
+	Email        string `json:"email"`
+	StatusChange bool   `json:"status_change"`
+	LowBalance   bool   `json:"low_balance"`
+	Invoice      bool   `json:"invoice"`
+}
@@ -0,0 +1,66 @@
+// Package webhook manages notification hooks, to be alerted about resource state changes.
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ekaputra07/warren-go/api"
+)
+
+func NewClient(client *api.API) *Client {
+	return &Client{
+		API: client,
+	}
+}
+
+// ListWebhooks https://api.warren.io/#list-webhooks
+func (c *Client) ListWebhooks(ctx context.Context) (*[]Webhook, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/user-resource/webhooks",
+	}
+	return api.Call[[]Webhook](ctx, c.API, rc)
+}
+
+// CreateWebhook https://api.warren.io/#create-webhook
+// Keep the returned Secret to verify notifications, it can't be retrieved again.
+func (c *Client) CreateWebhook(ctx context.Context, cfg CreateWebhookConfig) (*Webhook, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	rc := api.RequestConfig{
+		Method: "POST",
+		Path:   "/v1/user-resource/webhooks",
+		JSON:   cfg,
+	}
+	return api.CallJSON[Webhook](ctx, c.API, rc)
+}
+
+// DeleteWebhook https://api.warren.io/#delete-webhook
+func (c *Client) DeleteWebhook(ctx context.Context, id int) error {
+	rc := api.RequestConfig{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/v1/user-resource/webhooks/%d", id),
+	}
+	return c.API.FormRequest(ctx, rc).Error
+}
+
+// GetNotificationPreferences https://api.warren.io/#get-notification-preferences
+func (c *Client) GetNotificationPreferences(ctx context.Context) (*NotificationPreferences, error) {
+	rc := api.RequestConfig{
+		Method: "GET",
+		Path:   "/v1/user-resource/user/notifications",
+	}
+	return api.Call[NotificationPreferences](ctx, c.API, rc)
+}
+
+// UpdateNotificationPreferences https://api.warren.io/#update-notification-preferences
+func (c *Client) UpdateNotificationPreferences(ctx context.Context, prefs NotificationPreferences) (*NotificationPreferences, error) {
+	rc := api.RequestConfig{
+		Method: "PUT",
+		Path:   "/v1/user-resource/user/notifications",
+		JSON:   prefs,
+	}
+	return api.CallJSON[NotificationPreferences](ctx, c.API, rc)
+}
@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ekaputra07/warren-go/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListWebhooks(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/user-resource/webhooks", r.RequestURI)
+		w.Write([]byte(`[{"id":1,"url":"https://example.com/hook"}]`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	hooks, err := c.ListWebhooks(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, *hooks, 1)
+}
+
+func TestCreateWebhook(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/v1/user-resource/webhooks", r.RequestURI)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "https://example.com/hook", body["url"])
+		assert.Equal(t, []interface{}{EventVMStatusChanged}, body["events"])
+		w.Write([]byte(`{"id":1,"secret":"s3cret","enabled":true}`))
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	hook, err := c.CreateWebhook(context.Background(), CreateWebhookConfig{
+		URL:    "https://example.com/hook",
+		Events: []string{EventVMStatusChanged},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cret", hook.Secret)
+	assert.True(t, hook.Enabled)
+}
+
+func TestCreateWebhookConfig_Validate(t *testing.T) {
+	cfg := CreateWebhookConfig{URL: "https://example.com/hook", Events: []string{EventLowBalance}}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.URL = "http://example.com/hook"
+	assert.EqualError(t, cfg.Validate(), `URL with value of "http://example.com/hook" is invalid, must be absolute https URL`)
+
+	cfg.URL = "https://example.com/hook"
+	cfg.Events = []string{"vm.exploded"}
+	assert.EqualError(t, cfg.Validate(), "Events with value of [vm.exploded] is invalid, vm.exploded is unknown")
+
+	cfg.Events = nil
+	assert.EqualError(t, cfg.Validate(), "Events with value of [] is invalid, must not be empty")
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "/v1/user-resource/webhooks/1", r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	c.DeleteWebhook(context.Background(), 1)
+}
+
+func TestGetNotificationPreferences(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/notifications", r.RequestURI)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	c.GetNotificationPreferences(context.Background())
+}
+
+func TestUpdateNotificationPreferences(t *testing.T) {
+	a, s := api.MockClientServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "/v1/user-resource/user/notifications", r.RequestURI)
+
+		var prefs NotificationPreferences
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&prefs))
+		assert.True(t, prefs.LowBalance)
+		json.NewEncoder(w).Encode(prefs)
+	})
+	defer s.Close()
+
+	c := Client{API: a}
+	prefs, err := c.UpdateNotificationPreferences(context.Background(), NotificationPreferences{Email: "ops@example.com", LowBalance: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "ops@example.com", prefs.Email)
+}